@@ -0,0 +1,219 @@
+package arc
+
+import (
+	cache "github.com/hmcalister/Go-DSA/cache/Cache"
+	linkedhashmap "github.com/hmcalister/Go-DSA/map/LinkedHashMap"
+	linkedhashset "github.com/hmcalister/Go-DSA/set/LinkedHashSet"
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
+)
+
+// Implement an adaptive replacement cache (ARC), satisfying the github.com/hmcalister/Go-DSA/cache/Cache interface.
+//
+// ARC (Megiddo and Modha, 2003) balances recency against frequency, adapting the balance to the workload.
+// Entries seen once are kept in a recency list T1, and entries seen at least twice in a frequency list T2.
+// The keys recently evicted from each list are remembered, without their values, in the ghost lists B1 and B2.
+// A miss that hits a ghost list shows the corresponding list was too small, so the target size of T1 is moved towards it.
+//
+// Unlike an LRU cache, a single scan over many keys only passes through T1, and so does not flush the frequently used entries of T2.
+//
+// Each list is a github.com/hmcalister/Go-DSA/map/LinkedHashMap or github.com/hmcalister/Go-DSA/set/LinkedHashSet,
+// least recently used first, so Get, Put, and Remove are all O(1). The ghost lists hold at most capacity keys each.
+type ARC[K comparable, V any] struct {
+	t1 *linkedhashmap.LinkedHashMap[K, V]
+	t2 *linkedhashmap.LinkedHashMap[K, V]
+	b1 *linkedhashset.LinkedHashSet[K]
+	b2 *linkedhashset.LinkedHashSet[K]
+
+	capacity int
+
+	// The target size of T1, between zero and the capacity.
+	recencyTarget int
+
+	stats cache.Stats
+}
+
+// Create a new ARC cache holding at most capacity entries.
+//
+// Returns a dsa_error.ErrorInvalidCapacity if the capacity is not positive.
+func New[K comparable, V any](capacity int) (*ARC[K, V], error) {
+	if capacity <= 0 {
+		return nil, dsa_error.ErrorInvalidCapacity
+	}
+
+	return &ARC[K, V]{
+		t1:            linkedhashmap.New[K, V](linkedhashmap.AccessOrder),
+		t2:            linkedhashmap.New[K, V](linkedhashmap.AccessOrder),
+		b1:            linkedhashset.New[K](linkedhashset.InsertionOrder),
+		b2:            linkedhashset.New[K](linkedhashset.InsertionOrder),
+		capacity:      capacity,
+		recencyTarget: 0,
+	}, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helper Methods
+
+// Add a key to a ghost list, forgetting the oldest ghost if the list exceeds the capacity.
+func (arcCache *ARC[K, V]) addGhost(ghosts *linkedhashset.LinkedHashSet[K], key K) {
+	ghosts.Add(key)
+	if ghosts.Size() > arcCache.capacity {
+		ghosts.RemoveOldest()
+	}
+}
+
+// Evict the least recently used entry of either T1 or T2, remembering its key in the matching ghost list.
+//
+// T1 is chosen if it exceeds its target size. If the missed key was found in B2, T1 is also chosen when exactly at its target size.
+func (arcCache *ARC[K, V]) replace(foundInB2 bool) {
+	t1Size := arcCache.t1.Size()
+	if t1Size > 0 && (t1Size > arcCache.recencyTarget || (t1Size == arcCache.recencyTarget && foundInB2) || arcCache.t2.Size() == 0) {
+		key, _, _ := arcCache.t1.RemoveOldest()
+		arcCache.addGhost(arcCache.b1, key)
+	} else {
+		key, _, _ := arcCache.t2.RemoveOldest()
+		arcCache.addGhost(arcCache.b2, key)
+	}
+	arcCache.stats.Evictions += 1
+}
+
+// ----------------------------------------------------------------------------
+// Get Methods
+
+// Get the number of entries in the arcCache. Ghost keys are not counted.
+func (arcCache *ARC[K, V]) Len() int {
+	return arcCache.t1.Size() + arcCache.t2.Size()
+}
+
+// Get the capacity of the cache, the maximum number of entries.
+func (arcCache *ARC[K, V]) Capacity() int {
+	return arcCache.capacity
+}
+
+// Get the current target size of the recency list T1. The remaining capacity is targeted to the frequency list T2.
+func (arcCache *ARC[K, V]) RecencyTarget() int {
+	return arcCache.recencyTarget
+}
+
+// Get a snapshot of the counters of the arcCache.
+func (arcCache *ARC[K, V]) Stats() cache.Stats {
+	return arcCache.stats
+}
+
+// Get the value associated with a key, moving the entry to the frequency list as the most recently used.
+// A hit or miss is recorded in the counters of the arcCache.
+//
+// Returns a dsa_error.ErrorItemNotFound if the key is not present.
+func (arcCache *ARC[K, V]) Get(key K) (V, error) {
+	if value, err := arcCache.t1.Remove(key); err == nil {
+		arcCache.t2.Put(key, value)
+		arcCache.stats.Hits += 1
+		return value, nil
+	}
+	if value, err := arcCache.t2.Get(key); err == nil {
+		arcCache.stats.Hits += 1
+		return value, nil
+	}
+
+	arcCache.stats.Misses += 1
+	return *new(V), dsa_error.ErrorItemNotFound
+}
+
+// Get the value associated with a key without moving the entry, and without affecting the counters of the arcCache.
+//
+// Returns a dsa_error.ErrorItemNotFound if the key is not present.
+func (arcCache *ARC[K, V]) Peek(key K) (V, error) {
+	if value, err := arcCache.t1.Peek(key); err == nil {
+		return value, nil
+	}
+	return arcCache.t2.Peek(key)
+}
+
+// ----------------------------------------------------------------------------
+// Add Methods
+
+// Associate a value with a key.
+//
+// If the key is already present, its value is replaced and the entry moved to the frequency list as the most recently used.
+// If the key was recently evicted, the target size of the recency list adapts, and the entry is added to the frequency list.
+// Otherwise, the entry is added to the recency list. In either of the latter cases an entry may be evicted to make room.
+//
+// This method always returns nil, and returns an error only to satisfy the Cache interface.
+func (arcCache *ARC[K, V]) Put(key K, value V) error {
+	if arcCache.t1.ContainsKey(key) {
+		arcCache.t1.Remove(key)
+		arcCache.t2.Put(key, value)
+		return nil
+	}
+	if arcCache.t2.ContainsKey(key) {
+		arcCache.t2.Put(key, value)
+		return nil
+	}
+
+	if arcCache.b1.Contains(key) {
+		delta := 1
+		if arcCache.b2.Size() > arcCache.b1.Size() {
+			delta = arcCache.b2.Size() / arcCache.b1.Size()
+		}
+		arcCache.recencyTarget = min(arcCache.recencyTarget+delta, arcCache.capacity)
+
+		if arcCache.Len() >= arcCache.capacity {
+			arcCache.replace(false)
+		}
+		arcCache.b1.Remove(key)
+		arcCache.t2.Put(key, value)
+		return nil
+	}
+
+	if arcCache.b2.Contains(key) {
+		delta := 1
+		if arcCache.b1.Size() > arcCache.b2.Size() {
+			delta = arcCache.b1.Size() / arcCache.b2.Size()
+		}
+		arcCache.recencyTarget = max(arcCache.recencyTarget-delta, 0)
+
+		if arcCache.Len() >= arcCache.capacity {
+			arcCache.replace(true)
+		}
+		arcCache.b2.Remove(key)
+		arcCache.t2.Put(key, value)
+		return nil
+	}
+
+	if arcCache.Len() >= arcCache.capacity {
+		arcCache.replace(false)
+	}
+	if arcCache.b1.Size() > arcCache.capacity-arcCache.recencyTarget {
+		arcCache.b1.RemoveOldest()
+	}
+	if arcCache.b2.Size() > arcCache.recencyTarget {
+		arcCache.b2.RemoveOldest()
+	}
+	arcCache.t1.Put(key, value)
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Remove Methods
+
+// Remove a key from the cache, returning its value. The key is also forgotten by the ghost lists.
+//
+// Returns a dsa_error.ErrorItemNotFound if the key is not present.
+func (arcCache *ARC[K, V]) Remove(key K) (V, error) {
+	arcCache.b1.Remove(key)
+	arcCache.b2.Remove(key)
+
+	if value, err := arcCache.t1.Remove(key); err == nil {
+		return value, nil
+	}
+	return arcCache.t2.Remove(key)
+}
+
+// Remove all entries and ghost keys from the cache, and reset the target size of the recency list.
+// The counters of the cache are not reset.
+func (arcCache *ARC[K, V]) Clear() {
+	arcCache.t1.Clear()
+	arcCache.t2.Clear()
+	arcCache.b1 = linkedhashset.New[K](linkedhashset.InsertionOrder)
+	arcCache.b2 = linkedhashset.New[K](linkedhashset.InsertionOrder)
+	arcCache.recencyTarget = 0
+}
@@ -0,0 +1,131 @@
+package arc_test
+
+import (
+	"errors"
+	"testing"
+
+	arc "github.com/hmcalister/Go-DSA/cache/ARC"
+	cache "github.com/hmcalister/Go-DSA/cache/Cache"
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
+)
+
+// a helper method to check exactly which keys are present in a cache, without affecting the cache.
+//
+// calls t.Errorf if a key is present when it should not be, or missing when it should be present.
+func checkARCKeys(t *testing.T, arcCache *arc.ARC[int, int], presentKeys []int, absentKeys []int) {
+	t.Helper()
+
+	for _, key := range presentKeys {
+		if _, err := arcCache.Peek(key); err != nil {
+			t.Errorf("expected key (%v) to be present, found (%v)", key, err)
+		}
+	}
+	for _, key := range absentKeys {
+		if _, err := arcCache.Peek(key); !errors.Is(err, dsa_error.ErrorItemNotFound) {
+			t.Errorf("expected ErrorItemNotFound for absent key (%v), found (%v)", key, err)
+		}
+	}
+	if arcCache.Len() != len(presentKeys) {
+		t.Errorf("found length (%v) does not match expected length (%v)", arcCache.Len(), len(presentKeys))
+	}
+}
+
+func TestARCInit(t *testing.T) {
+	t.Run("int", func(t *testing.T) {
+		arc.New[int, int](10)
+	})
+	t.Run("string", func(t *testing.T) {
+		arc.New[string, []byte](10)
+	})
+	t.Run("invalid capacity", func(t *testing.T) {
+		_, err := arc.New[int, int](-1)
+		if !errors.Is(err, dsa_error.ErrorInvalidCapacity) {
+			t.Errorf("did not encounter expected error (%v) when creating cache with invalid capacity, found %v", dsa_error.ErrorInvalidCapacity, err)
+		}
+	})
+}
+
+func TestARCGhostHitAdapts(t *testing.T) {
+	arcCache, _ := arc.New[int, int](2)
+	arcCache.Put(1, 10)
+	arcCache.Put(2, 20)
+	arcCache.Put(3, 30)
+	checkARCKeys(t, arcCache, []int{2, 3}, []int{1})
+	if arcCache.RecencyTarget() != 0 {
+		t.Errorf("found recency target (%v) does not match expected target (%v)", arcCache.RecencyTarget(), 0)
+	}
+
+	// Key 1 is remembered in B1, so putting it again grows the recency target
+	arcCache.Put(1, 11)
+	checkARCKeys(t, arcCache, []int{1, 3}, []int{2})
+	if arcCache.RecencyTarget() != 1 {
+		t.Errorf("found recency target (%v) does not match expected target (%v)", arcCache.RecencyTarget(), 1)
+	}
+	if value, err := arcCache.Get(1); err != nil || value != 11 {
+		t.Errorf("found value (%v, %v) does not match expected value (%v, %v)", value, err, 11, nil)
+	}
+}
+
+func TestARCScanResistance(t *testing.T) {
+	arcCache, _ := arc.New[int, int](4)
+	arcCache.Put(1, 10)
+	arcCache.Put(2, 20)
+	arcCache.Get(1)
+	arcCache.Get(2)
+
+	// Keys used once never displace keys used twice
+	for key := 100; key < 200; key += 1 {
+		arcCache.Put(key, key)
+	}
+	checkARCKeys(t, arcCache, []int{1, 2, 198, 199}, []int{100, 197})
+}
+
+func TestARCRemove(t *testing.T) {
+	arcCache, _ := arc.New[int, int](2)
+	arcCache.Put(1, 10)
+	arcCache.Put(2, 20)
+	arcCache.Get(2)
+
+	if value, err := arcCache.Remove(1); err != nil || value != 10 {
+		t.Errorf("found removed value (%v, %v) does not match expected value (%v, %v)", value, err, 10, nil)
+	}
+	if value, err := arcCache.Remove(2); err != nil || value != 20 {
+		t.Errorf("found removed value (%v, %v) does not match expected value (%v, %v)", value, err, 20, nil)
+	}
+	if _, err := arcCache.Remove(2); !errors.Is(err, dsa_error.ErrorItemNotFound) {
+		t.Errorf("expected ErrorItemNotFound when removing missing key, found (%v)", err)
+	}
+	checkARCKeys(t, arcCache, []int{}, []int{1, 2})
+
+	// A removed key is forgotten by the ghost lists, so does not adapt the recency target
+	arcCache.Put(1, 10)
+	arcCache.Put(2, 20)
+	arcCache.Put(3, 30)
+	arcCache.Remove(1)
+	arcCache.Put(1, 11)
+	if arcCache.RecencyTarget() != 0 {
+		t.Errorf("found recency target (%v) does not match expected target (%v)", arcCache.RecencyTarget(), 0)
+	}
+
+	arcCache.Clear()
+	checkARCKeys(t, arcCache, []int{}, []int{1, 2, 3})
+}
+
+func TestARCStats(t *testing.T) {
+	arcCache, _ := arc.New[int, int](2)
+	arcCache.Put(1, 10)
+	arcCache.Get(1)
+	arcCache.Get(1)
+	arcCache.Get(2)
+	arcCache.Put(2, 20)
+	arcCache.Put(3, 30)
+
+	expectedStats := cache.Stats{
+		Hits:      2,
+		Misses:    1,
+		Evictions: 1,
+	}
+	if stats := arcCache.Stats(); stats != expectedStats {
+		t.Errorf("found stats (%+v) does not match expected stats (%+v)", stats, expectedStats)
+	}
+}
@@ -0,0 +1,51 @@
+package cache
+
+// A Cache holds a bounded number of key-value pairs, choosing which pairs to evict when full according to a policy.
+//
+// Implementations of this interface, such as github.com/hmcalister/Go-DSA/cache/LRU, differ only in their eviction policy,
+// so the policy can be chosen per deployment while the code using the cache is unchanged.
+type Cache[K comparable, V any] interface {
+	// Get the value associated with a key, recording a hit or miss in the counters of the cache.
+	// A hit may affect which entries are later evicted, depending on the policy.
+	//
+	// Returns a dsa_error.ErrorItemNotFound if the key is not present.
+	Get(key K) (V, error)
+
+	// Associate a value with a key, evicting other entries according to the policy if the cache is full.
+	Put(key K, value V) error
+
+	// Remove a key from the cache, returning its value.
+	//
+	// Returns a dsa_error.ErrorItemNotFound if the key is not present.
+	Remove(key K) (V, error)
+
+	// Get the number of entries in the cache.
+	Len() int
+
+	// Get a snapshot of the counters of the cache.
+	Stats() Stats
+}
+
+// Counters describing the use of a cache.
+type Stats struct {
+	// The number of calls to Get that found an entry.
+	Hits int
+
+	// The number of calls to Get that did not find an entry, including those that found an expired entry.
+	Misses int
+
+	// The number of entries evicted to make room for other entries.
+	Evictions int
+
+	// The number of entries removed because their time to live had passed. Only caches supporting expiry record expirations.
+	Expirations int
+}
+
+// Get the fraction of calls to Get that found an entry. Returns zero if Get has not been called.
+func (stats Stats) HitRatio() float64 {
+	lookups := stats.Hits + stats.Misses
+	if lookups == 0 {
+		return 0
+	}
+	return float64(stats.Hits) / float64(lookups)
+}
@@ -0,0 +1,238 @@
+package cache_test
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	arc "github.com/hmcalister/Go-DSA/cache/ARC"
+	cache "github.com/hmcalister/Go-DSA/cache/Cache"
+	lfu "github.com/hmcalister/Go-DSA/cache/LFU"
+	lru "github.com/hmcalister/Go-DSA/cache/LRU"
+	twoq "github.com/hmcalister/Go-DSA/cache/TwoQ"
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
+)
+
+var (
+	_ cache.Cache[int, int] = (*lru.LRU[int, int])(nil)
+	_ cache.Cache[int, int] = (*lfu.LFU[int, int])(nil)
+	_ cache.Cache[int, int] = (*arc.ARC[int, int])(nil)
+	_ cache.Cache[int, int] = (*twoq.TwoQ[int, int])(nil)
+)
+
+// A named constructor of a cache, so every policy can be tested alike.
+type policy struct {
+	name string
+	new  func(capacity int) cache.Cache[int, int]
+}
+
+var policies = []policy{
+	{"LRU", func(capacity int) cache.Cache[int, int] {
+		lruCache, _ := lru.New[int, int](capacity)
+		return lruCache
+	}},
+	{"LFU", func(capacity int) cache.Cache[int, int] {
+		lfuCache, _ := lfu.New[int, int](capacity)
+		return lfuCache
+	}},
+	{"ARC", func(capacity int) cache.Cache[int, int] {
+		arcCache, _ := arc.New[int, int](capacity)
+		return arcCache
+	}},
+	{"2Q", func(capacity int) cache.Cache[int, int] {
+		twoQCache, _ := twoq.New[int, int](capacity)
+		return twoQCache
+	}},
+}
+
+func TestStatsHitRatio(t *testing.T) {
+	if ratio := (cache.Stats{}).HitRatio(); ratio != 0 {
+		t.Errorf("found hit ratio (%v) does not match expected hit ratio (%v)", ratio, 0)
+	}
+	if ratio := (cache.Stats{Hits: 3, Misses: 1, Evictions: 10}).HitRatio(); ratio != 0.75 {
+		t.Errorf("found hit ratio (%v) does not match expected hit ratio (%v)", ratio, 0.75)
+	}
+}
+
+// Every policy must behave identically while the cache is not full, and never exceed its capacity.
+func TestCacheContract(t *testing.T) {
+	capacity := 16
+	for _, p := range policies {
+		t.Run(p.name, func(t *testing.T) {
+			c := p.new(capacity)
+			for key := range capacity {
+				if err := c.Put(key, -key); err != nil {
+					t.Errorf("error when putting key (%v): %v", key, err)
+				}
+			}
+			if c.Len() != capacity {
+				t.Errorf("found length (%v) does not match expected length (%v)", c.Len(), capacity)
+			}
+			for key := range capacity {
+				if value, err := c.Get(key); err != nil || value != -key {
+					t.Errorf("found value (%v, %v) does not match expected value (%v, %v)", value, err, -key, nil)
+				}
+			}
+			if _, err := c.Get(capacity); !errors.Is(err, dsa_error.ErrorItemNotFound) {
+				t.Errorf("expected ErrorItemNotFound for missing key, found (%v)", err)
+			}
+
+			c.Put(0, 100)
+			if value, err := c.Get(0); err != nil || value != 100 {
+				t.Errorf("found updated value (%v, %v) does not match expected value (%v, %v)", value, err, 100, nil)
+			}
+			if value, err := c.Remove(0); err != nil || value != 100 {
+				t.Errorf("found removed value (%v, %v) does not match expected value (%v, %v)", value, err, 100, nil)
+			}
+			if _, err := c.Remove(0); !errors.Is(err, dsa_error.ErrorItemNotFound) {
+				t.Errorf("expected ErrorItemNotFound when removing missing key, found (%v)", err)
+			}
+
+			expectedStats := cache.Stats{Hits: capacity + 1, Misses: 1}
+			if stats := c.Stats(); stats != expectedStats {
+				t.Errorf("found stats (%+v) does not match expected stats (%+v)", stats, expectedStats)
+			}
+
+			for key := range 10 * capacity {
+				c.Put(key, key)
+				if c.Len() > capacity {
+					t.Fatalf("found length (%v) exceeds capacity (%v)", c.Len(), capacity)
+				}
+			}
+			if stats := c.Stats(); stats.Evictions != 9*capacity {
+				t.Errorf("found evictions (%v) does not match expected evictions (%v)", stats.Evictions, 9*capacity)
+			}
+		})
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Trace replay
+//
+// Synthetic traces of key requests are replayed against each policy, as a demand-filled cache would see them:
+// a Get for each request, followed by a Put on a miss. The traces are generated from a fixed seed, so the hit ratios are deterministic.
+
+// A named sequence of requested keys.
+type trace struct {
+	name     string
+	requests []int
+}
+
+// Keys requested with a Zipf distribution over a large key space, as seen by many web caches.
+func zipfTrace(numRequests int) trace {
+	randomGenerator := rand.New(rand.NewPCG(0, 0))
+	zipf := rand.NewZipf(randomGenerator, 1.1, 1, 9999)
+	requests := make([]int, numRequests)
+	for index := range requests {
+		requests[index] = int(zipf.Uint64())
+	}
+	return trace{"zipf", requests}
+}
+
+// Keys requested in a loop slightly larger than the cache, the worst case of LRU.
+func loopTrace(numRequests int, loopLength int) trace {
+	requests := make([]int, numRequests)
+	for index := range requests {
+		requests[index] = index % loopLength
+	}
+	return trace{"loop", requests}
+}
+
+// Keys requested uniformly from a small hot set, interrupted periodically by a long scan of keys never requested again.
+func scanTrace(numRequests int, hotSetSize int, scanPeriod int, scanLength int) trace {
+	randomGenerator := rand.New(rand.NewPCG(0, 0))
+	requests := make([]int, 0, numRequests)
+	nextScanKey := hotSetSize
+	for len(requests) < numRequests {
+		if len(requests)%scanPeriod == 0 {
+			for range scanLength {
+				requests = append(requests, nextScanKey)
+				nextScanKey += 1
+			}
+		}
+		requests = append(requests, randomGenerator.IntN(hotSetSize))
+	}
+	return trace{"scan", requests[:numRequests]}
+}
+
+// Keys requested uniformly from a hot set that is replaced entirely every phase, where past popularity is misleading.
+func shiftingTrace(numRequests int, hotSetSize int, phaseLength int) trace {
+	randomGenerator := rand.New(rand.NewPCG(0, 0))
+	requests := make([]int, numRequests)
+	for index := range requests {
+		phase := index / phaseLength
+		requests[index] = phase*hotSetSize + randomGenerator.IntN(hotSetSize)
+	}
+	return trace{"shifting", requests}
+}
+
+// Replay a trace against a cache, returning the counters of the cache afterwards.
+func replay(c cache.Cache[int, int], requests []int) cache.Stats {
+	for _, key := range requests {
+		if _, err := c.Get(key); err != nil {
+			c.Put(key, key)
+		}
+	}
+	return c.Stats()
+}
+
+// Replay each trace against each policy, reporting the hit ratios, and check the relationships between policies
+// that follow from the design of each policy rather than from tuning.
+func TestCacheTraceReplay(t *testing.T) {
+	capacity := 100
+	traces := []trace{
+		zipfTrace(100000),
+		loopTrace(100000, capacity+capacity/2),
+		scanTrace(100000, capacity/2, 1000, capacity),
+		shiftingTrace(100000, capacity/2, 10000),
+	}
+
+	hitRatios := make(map[string]map[string]float64)
+	for _, currentTrace := range traces {
+		hitRatios[currentTrace.name] = make(map[string]float64)
+		report := fmt.Sprintf("%-10v", currentTrace.name)
+		for _, p := range policies {
+			stats := replay(p.new(capacity), currentTrace.requests)
+			if stats.Hits+stats.Misses != len(currentTrace.requests) {
+				t.Errorf("%v on %v: found lookups (%v) does not match expected lookups (%v)", p.name, currentTrace.name, stats.Hits+stats.Misses, len(currentTrace.requests))
+			}
+
+			// Replaying the same trace against a fresh cache must give the same result
+			if repeatStats := replay(p.new(capacity), currentTrace.requests); repeatStats != stats {
+				t.Errorf("%v on %v: found repeated stats (%+v) does not match stats (%+v)", p.name, currentTrace.name, repeatStats, stats)
+			}
+
+			hitRatios[currentTrace.name][p.name] = stats.HitRatio()
+			report += fmt.Sprintf("  %v %.4f", p.name, stats.HitRatio())
+		}
+		t.Log(report)
+	}
+
+	// LRU always evicts the key requested next in a loop larger than the cache.
+	// The other policies fare little better, as no key is requested twice before it is evicted (or forgotten as a ghost).
+	if hitRatios["loop"]["LRU"] != 0 {
+		t.Errorf("found LRU hit ratio (%v) on loop trace does not match expected hit ratio (%v)", hitRatios["loop"]["LRU"], 0)
+	}
+
+	// Policies considering frequency keep more of the popular keys of a skewed distribution
+	for _, name := range []string{"LFU", "ARC", "2Q"} {
+		if hitRatios["zipf"][name] <= hitRatios["zipf"]["LRU"] {
+			t.Errorf("expected %v hit ratio (%v) to exceed LRU hit ratio (%v) on zipf trace", name, hitRatios["zipf"][name], hitRatios["zipf"]["LRU"])
+		}
+	}
+
+	// Scan resistant policies keep the hot set through each scan, while LRU is flushed
+	for _, name := range []string{"LFU", "ARC", "2Q"} {
+		if hitRatios["scan"][name] <= hitRatios["scan"]["LRU"] {
+			t.Errorf("expected %v hit ratio (%v) to exceed LRU hit ratio (%v) on scan trace", name, hitRatios["scan"][name], hitRatios["scan"]["LRU"])
+		}
+	}
+
+	// LFU holds on to the popular keys of past phases, while the adaptive policies follow the shift
+	for _, name := range []string{"LRU", "ARC", "2Q"} {
+		if hitRatios["shifting"][name] <= hitRatios["shifting"]["LFU"] {
+			t.Errorf("expected %v hit ratio (%v) to exceed LFU hit ratio (%v) on shifting trace", name, hitRatios["shifting"][name], hitRatios["shifting"]["LFU"])
+		}
+	}
+}
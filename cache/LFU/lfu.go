@@ -0,0 +1,219 @@
+package lfu
+
+import (
+	cache "github.com/hmcalister/Go-DSA/cache/Cache"
+	linkedlist "github.com/hmcalister/Go-DSA/list/LinkedList"
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
+)
+
+// An entry of the cache, stored in the list of the frequency bucket it currently belongs to.
+type entry[K comparable, V any] struct {
+	key   K
+	value V
+
+	// The node of the bucket holding this entry, in the list of buckets.
+	bucket *linkedlist.LinkedListNode[*frequencyBucket[K, V]]
+}
+
+// All entries accessed the same number of times, least recently used at the front.
+type frequencyBucket[K comparable, V any] struct {
+	frequency int
+	entries   *linkedlist.LinkedList[*entry[K, V]]
+}
+
+// Implement a least frequently used (LFU) cache, satisfying the github.com/hmcalister/Go-DSA/cache/Cache interface.
+//
+// The cache holds at most a fixed number of entries. When adding an entry to a full cache,
+// the entry accessed the fewest times is evicted. Ties are broken by evicting the least recently used of those entries.
+// Each Get or Put of an existing key counts as one access.
+//
+// Entries are grouped into buckets by their access frequency, and the buckets are kept in a
+// github.com/hmcalister/Go-DSA/list/LinkedList ordered by increasing frequency. An access moves an entry to the
+// neighbouring bucket, and eviction takes from the first bucket, so Get, Put, and Remove are all O(1).
+//
+// Frequencies are never decayed, so an entry that was popular long ago may stay in the cache after it is no longer used.
+// For workloads with shifting popularity, consider github.com/hmcalister/Go-DSA/cache/ARC instead.
+type LFU[K comparable, V any] struct {
+	index   map[K]*linkedlist.LinkedListNode[*entry[K, V]]
+	buckets *linkedlist.LinkedList[*frequencyBucket[K, V]]
+
+	capacity int
+	stats    cache.Stats
+}
+
+// Create a new LFU cache holding at most capacity entries.
+//
+// Returns a dsa_error.ErrorInvalidCapacity if the capacity is not positive.
+func New[K comparable, V any](capacity int) (*LFU[K, V], error) {
+	if capacity <= 0 {
+		return nil, dsa_error.ErrorInvalidCapacity
+	}
+
+	return &LFU[K, V]{
+		index:    make(map[K]*linkedlist.LinkedListNode[*entry[K, V]]),
+		buckets:  linkedlist.New[*frequencyBucket[K, V]](),
+		capacity: capacity,
+	}, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helper Methods
+
+// Create a new, empty bucket for the given frequency.
+func newFrequencyBucket[K comparable, V any](frequency int) *frequencyBucket[K, V] {
+	return &frequencyBucket[K, V]{
+		frequency: frequency,
+		entries:   linkedlist.New[*entry[K, V]](),
+	}
+}
+
+// Remove a node from the bucket holding it, removing the bucket too if it is left empty.
+func (lfuCache *LFU[K, V]) unlinkNode(node *linkedlist.LinkedListNode[*entry[K, V]]) {
+	bucketNode := node.Item().bucket
+	bucketNode.Item().entries.RemoveNode(node)
+	if bucketNode.Item().entries.Length() == 0 {
+		lfuCache.buckets.RemoveNode(bucketNode)
+	}
+}
+
+// Move the entry of a node to the bucket for one more access, creating the bucket if needed.
+func (lfuCache *LFU[K, V]) incrementFrequency(node *linkedlist.LinkedListNode[*entry[K, V]]) {
+	currentEntry := node.Item()
+	bucketNode := currentEntry.bucket
+	frequency := bucketNode.Item().frequency
+
+	nextBucketNode := bucketNode.Next()
+	if nextBucketNode == nil || nextBucketNode.Item().frequency != frequency+1 {
+		nextBucketNode, _ = lfuCache.buckets.InsertAfter(newFrequencyBucket[K, V](frequency+1), bucketNode)
+	}
+
+	lfuCache.unlinkNode(node)
+	nextBucketNode.Item().entries.Add(currentEntry)
+	currentEntry.bucket = nextBucketNode
+	lfuCache.index[currentEntry.key] = nextBucketNode.Item().entries.Back()
+}
+
+// Evict the least recently used entry of the lowest frequency bucket.
+func (lfuCache *LFU[K, V]) evict() {
+	node := lfuCache.buckets.Front().Item().entries.Front()
+	lfuCache.unlinkNode(node)
+	delete(lfuCache.index, node.Item().key)
+	lfuCache.stats.Evictions += 1
+}
+
+// ----------------------------------------------------------------------------
+// Get Methods
+
+// Get the number of entries in the lfuCache.
+func (lfuCache *LFU[K, V]) Len() int {
+	return len(lfuCache.index)
+}
+
+// Get the capacity of the cache, the maximum number of entries.
+func (lfuCache *LFU[K, V]) Capacity() int {
+	return lfuCache.capacity
+}
+
+// Get a snapshot of the counters of the lfuCache.
+func (lfuCache *LFU[K, V]) Stats() cache.Stats {
+	return lfuCache.stats
+}
+
+// Get the number of times the entry of a key has been accessed, including the Put that added it.
+// This does not count as an access.
+//
+// Returns a dsa_error.ErrorItemNotFound if the key is not present.
+func (lfuCache *LFU[K, V]) Frequency(key K) (int, error) {
+	node, ok := lfuCache.index[key]
+	if !ok {
+		return 0, dsa_error.ErrorItemNotFound
+	}
+	return node.Item().bucket.Item().frequency, nil
+}
+
+// Get the value associated with a key, counting an access of the entry.
+// A hit or miss is recorded in the counters of the lfuCache.
+//
+// Returns a dsa_error.ErrorItemNotFound if the key is not present.
+func (lfuCache *LFU[K, V]) Get(key K) (V, error) {
+	node, ok := lfuCache.index[key]
+	if !ok {
+		lfuCache.stats.Misses += 1
+		return *new(V), dsa_error.ErrorItemNotFound
+	}
+
+	lfuCache.stats.Hits += 1
+	lfuCache.incrementFrequency(node)
+	return node.Item().value, nil
+}
+
+// Get the value associated with a key without counting an access, and without affecting the counters of the lfuCache.
+//
+// Returns a dsa_error.ErrorItemNotFound if the key is not present.
+func (lfuCache *LFU[K, V]) Peek(key K) (V, error) {
+	node, ok := lfuCache.index[key]
+	if !ok {
+		return *new(V), dsa_error.ErrorItemNotFound
+	}
+	return node.Item().value, nil
+}
+
+// ----------------------------------------------------------------------------
+// Add Methods
+
+// Associate a value with a key.
+//
+// If the key is already present, its value is replaced and an access of the entry is counted.
+// Otherwise, if the cache is full the least frequently used entry is evicted, and the new entry is added with a frequency of one.
+//
+// This method always returns nil, and returns an error only to satisfy the Cache interface.
+func (lfuCache *LFU[K, V]) Put(key K, value V) error {
+	if node, ok := lfuCache.index[key]; ok {
+		node.Item().value = value
+		lfuCache.incrementFrequency(node)
+		return nil
+	}
+
+	if len(lfuCache.index) >= lfuCache.capacity {
+		lfuCache.evict()
+	}
+
+	firstBucketNode := lfuCache.buckets.Front()
+	if firstBucketNode == nil {
+		lfuCache.buckets.Add(newFrequencyBucket[K, V](1))
+		firstBucketNode = lfuCache.buckets.Front()
+	} else if firstBucketNode.Item().frequency != 1 {
+		firstBucketNode, _ = lfuCache.buckets.InsertBefore(newFrequencyBucket[K, V](1), firstBucketNode)
+	}
+
+	firstBucketNode.Item().entries.Add(&entry[K, V]{
+		key:    key,
+		value:  value,
+		bucket: firstBucketNode,
+	})
+	lfuCache.index[key] = firstBucketNode.Item().entries.Back()
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Remove Methods
+
+// Remove a key from the cache, returning its value.
+//
+// Returns a dsa_error.ErrorItemNotFound if the key is not present.
+func (lfuCache *LFU[K, V]) Remove(key K) (V, error) {
+	node, ok := lfuCache.index[key]
+	if !ok {
+		return *new(V), dsa_error.ErrorItemNotFound
+	}
+
+	lfuCache.unlinkNode(node)
+	delete(lfuCache.index, key)
+	return node.Item().value, nil
+}
+
+// Remove all entries from the lfuCache. The counters of the cache are not reset.
+func (lfuCache *LFU[K, V]) Clear() {
+	lfuCache.index = make(map[K]*linkedlist.LinkedListNode[*entry[K, V]])
+	lfuCache.buckets = linkedlist.New[*frequencyBucket[K, V]]()
+}
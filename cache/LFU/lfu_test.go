@@ -0,0 +1,175 @@
+package lfu_test
+
+import (
+	"errors"
+	"math/rand/v2"
+	"testing"
+
+	cache "github.com/hmcalister/Go-DSA/cache/Cache"
+	lfu "github.com/hmcalister/Go-DSA/cache/LFU"
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
+)
+
+// a helper method to check the frequency of each key of a cache.
+//
+// calls t.Errorf if the cache does not contain exactly the expected keys with the expected frequencies.
+func checkLFUFrequencies(t *testing.T, lfuCache *lfu.LFU[int, int], expectedFrequencies map[int]int) {
+	t.Helper()
+
+	for key, expectedFrequency := range expectedFrequencies {
+		if frequency, err := lfuCache.Frequency(key); err != nil || frequency != expectedFrequency {
+			t.Errorf("found frequency (%v, %v) of key (%v) does not match expected frequency (%v, %v)", frequency, err, key, expectedFrequency, nil)
+		}
+	}
+	if lfuCache.Len() != len(expectedFrequencies) {
+		t.Errorf("found length (%v) does not match expected length (%v)", lfuCache.Len(), len(expectedFrequencies))
+	}
+}
+
+func TestLFUInit(t *testing.T) {
+	t.Run("int", func(t *testing.T) {
+		lfu.New[int, int](10)
+	})
+	t.Run("string", func(t *testing.T) {
+		lfu.New[string, []byte](10)
+	})
+	t.Run("invalid capacity", func(t *testing.T) {
+		_, err := lfu.New[int, int](0)
+		if !errors.Is(err, dsa_error.ErrorInvalidCapacity) {
+			t.Errorf("did not encounter expected error (%v) when creating cache with invalid capacity, found %v", dsa_error.ErrorInvalidCapacity, err)
+		}
+	})
+}
+
+func TestLFUEvictsLeastFrequent(t *testing.T) {
+	lfuCache, _ := lfu.New[int, int](3)
+	lfuCache.Put(1, 10)
+	lfuCache.Put(2, 20)
+	lfuCache.Put(3, 30)
+	lfuCache.Get(1)
+	lfuCache.Get(1)
+	lfuCache.Put(2, 21)
+	checkLFUFrequencies(t, lfuCache, map[int]int{1: 3, 2: 2, 3: 1})
+
+	// Key 3 has the lowest frequency
+	lfuCache.Put(4, 40)
+	checkLFUFrequencies(t, lfuCache, map[int]int{1: 3, 2: 2, 4: 1})
+	if _, err := lfuCache.Peek(3); !errors.Is(err, dsa_error.ErrorItemNotFound) {
+		t.Errorf("expected ErrorItemNotFound for evicted key, found (%v)", err)
+	}
+
+	// Ties are broken by evicting the least recently used
+	lfuCache.Get(4)
+	lfuCache.Put(5, 50)
+	lfuCache.Get(5)
+	lfuCache.Put(6, 60)
+	checkLFUFrequencies(t, lfuCache, map[int]int{1: 3, 5: 2, 6: 1})
+	if value, err := lfuCache.Peek(5); err != nil || value != 50 {
+		t.Errorf("found value (%v, %v) does not match expected value (%v, %v)", value, err, 50, nil)
+	}
+}
+
+func TestLFURemove(t *testing.T) {
+	lfuCache, _ := lfu.New[int, int](3)
+	lfuCache.Put(1, 10)
+	lfuCache.Put(2, 20)
+	lfuCache.Get(2)
+
+	if value, err := lfuCache.Remove(2); err != nil || value != 20 {
+		t.Errorf("found removed value (%v, %v) does not match expected value (%v, %v)", value, err, 20, nil)
+	}
+	if _, err := lfuCache.Remove(2); !errors.Is(err, dsa_error.ErrorItemNotFound) {
+		t.Errorf("expected ErrorItemNotFound when removing missing key, found (%v)", err)
+	}
+	if _, err := lfuCache.Frequency(2); !errors.Is(err, dsa_error.ErrorItemNotFound) {
+		t.Errorf("expected ErrorItemNotFound for frequency of missing key, found (%v)", err)
+	}
+	checkLFUFrequencies(t, lfuCache, map[int]int{1: 1})
+
+	// A re-added key starts again from a frequency of one
+	lfuCache.Put(2, 22)
+	checkLFUFrequencies(t, lfuCache, map[int]int{1: 1, 2: 1})
+
+	lfuCache.Clear()
+	checkLFUFrequencies(t, lfuCache, map[int]int{})
+	lfuCache.Put(3, 30)
+	checkLFUFrequencies(t, lfuCache, map[int]int{3: 1})
+}
+
+func TestLFUStats(t *testing.T) {
+	lfuCache, _ := lfu.New[int, int](2)
+	lfuCache.Put(1, 10)
+	lfuCache.Get(1)
+	lfuCache.Get(2)
+	lfuCache.Put(2, 20)
+	lfuCache.Put(3, 30)
+	lfuCache.Peek(1)
+
+	expectedStats := cache.Stats{
+		Hits:      1,
+		Misses:    1,
+		Evictions: 1,
+	}
+	if stats := lfuCache.Stats(); stats != expectedStats {
+		t.Errorf("found stats (%+v) does not match expected stats (%+v)", stats, expectedStats)
+	}
+}
+
+// The cache must agree with a simple map based reference implementation, which finds the entry to evict by a linear scan.
+func TestLFURandomOperations(t *testing.T) {
+	type referenceEntry struct {
+		value      int
+		frequency  int
+		lastAccess int
+	}
+
+	randomGenerator := rand.New(rand.NewPCG(0, 0))
+	capacity := 8
+	lfuCache, _ := lfu.New[int, int](capacity)
+	reference := make(map[int]*referenceEntry)
+
+	for step := range 5000 {
+		key := randomGenerator.IntN(20)
+		switch randomGenerator.IntN(3) {
+		case 0:
+			lfuCache.Put(key, step)
+			if currentEntry, ok := reference[key]; ok {
+				currentEntry.value = step
+				currentEntry.frequency += 1
+				currentEntry.lastAccess = step
+				break
+			}
+			if len(reference) >= capacity {
+				evictedKey := -1
+				for candidateKey, candidate := range reference {
+					if evictedKey == -1 ||
+						candidate.frequency < reference[evictedKey].frequency ||
+						(candidate.frequency == reference[evictedKey].frequency && candidate.lastAccess < reference[evictedKey].lastAccess) {
+						evictedKey = candidateKey
+					}
+				}
+				delete(reference, evictedKey)
+			}
+			reference[key] = &referenceEntry{value: step, frequency: 1, lastAccess: step}
+		case 1:
+			value, err := lfuCache.Get(key)
+			currentEntry, expectedPresent := reference[key]
+			if (err == nil) != expectedPresent || (expectedPresent && value != currentEntry.value) {
+				t.Fatalf("step %v: found value (%v, %v) for key (%v) does not match expected presence (%v)", step, value, err, key, expectedPresent)
+			}
+			if expectedPresent {
+				currentEntry.frequency += 1
+				currentEntry.lastAccess = step
+			}
+		case 2:
+			lfuCache.Remove(key)
+			delete(reference, key)
+		}
+	}
+
+	expectedFrequencies := make(map[int]int)
+	for key, currentEntry := range reference {
+		expectedFrequencies[key] = currentEntry.frequency
+	}
+	checkLFUFrequencies(t, lfuCache, expectedFrequencies)
+}
@@ -0,0 +1,8 @@
+package lru
+
+import "errors"
+
+var (
+	ErrorInvalidCost         = errors.New("cost must not be negative")
+	ErrorCostExceedsCapacity = errors.New("cost of entry exceeds capacity of cache")
+)
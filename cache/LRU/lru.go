@@ -0,0 +1,333 @@
+package lru
+
+import (
+	"time"
+
+	cache "github.com/hmcalister/Go-DSA/cache/Cache"
+	linkedlist "github.com/hmcalister/Go-DSA/list/LinkedList"
+	clock "github.com/hmcalister/Go-DSA/utils/Clock"
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
+)
+
+// The reason an entry was evicted from the cache, passed to the eviction callback.
+type EvictionReason int
+
+const (
+	// The entry was the least recently used, and was evicted to make room for other entries.
+	EvictionCapacity EvictionReason = iota
+
+	// The entry's time to live had passed.
+	EvictionExpired EvictionReason = iota
+)
+
+// Counters describing the use of a cache.
+//
+// This is an alias of the Stats type of github.com/hmcalister/Go-DSA/cache/Cache, which is shared by all caches.
+type Stats = cache.Stats
+
+// An entry of the cache, stored in the recency list.
+type entry[K comparable, V any] struct {
+	key   K
+	value V
+	cost  int
+
+	// The time after which the entry is expired. The zero time means the entry never expires.
+	expiresAt time.Time
+}
+
+// Implement a least recently used (LRU) cache, satisfying the github.com/hmcalister/Go-DSA/cache/Cache interface.
+//
+// The cache holds entries up to a capacity. By default each entry costs one, so the capacity is a count of entries,
+// but a cost function may be given (see NewWithCost) to bound the cache by, say, the total size in bytes of the values.
+// When adding an entry would exceed the capacity, the least recently used entries are evicted until the cache fits.
+//
+// A Go map indexes the nodes of a github.com/hmcalister/Go-DSA/list/LinkedList ordered by recency,
+// least recently used at the front. Hence Get, Put, Peek, and Remove are all O(1).
+//
+// Entries may optionally expire after a time to live, measured by an injectable clock (see SetClock).
+// Expired entries are removed lazily when accessed, or eagerly by PurgeExpired.
+type LRU[K comparable, V any] struct {
+	index   map[K]*linkedlist.LinkedListNode[*entry[K, V]]
+	recency *linkedlist.LinkedList[*entry[K, V]]
+
+	capacity     int
+	totalCost    int
+	costFunction func(key K, value V) int
+
+	clock      clock.Clock
+	defaultTTL time.Duration
+
+	// Called with each entry that is evicted or expires. May be nil.
+	evictionCallback func(key K, value V, reason EvictionReason)
+
+	stats Stats
+}
+
+// Create a new LRU cache holding at most capacity entries.
+//
+// Returns a dsa_error.ErrorInvalidCapacity if the capacity is not positive.
+func New[K comparable, V any](capacity int) (*LRU[K, V], error) {
+	return NewWithCost(capacity, func(key K, value V) int { return 1 })
+}
+
+// Create a new LRU cache where the total cost of all entries is at most capacity.
+// The cost of each entry is found by calling the cost function when the entry is put, and must not be negative.
+//
+// Returns a dsa_error.ErrorInvalidCapacity if the capacity is not positive.
+func NewWithCost[K comparable, V any](capacity int, costFunction func(key K, value V) int) (*LRU[K, V], error) {
+	if capacity <= 0 {
+		return nil, dsa_error.ErrorInvalidCapacity
+	}
+
+	return &LRU[K, V]{
+		index:            make(map[K]*linkedlist.LinkedListNode[*entry[K, V]]),
+		recency:          linkedlist.New[*entry[K, V]](),
+		capacity:         capacity,
+		totalCost:        0,
+		costFunction:     costFunction,
+		clock:            clock.SystemClock{},
+		defaultTTL:       0,
+		evictionCallback: nil,
+	}, nil
+}
+
+// Set the clock used to measure time to live. By default, the cache uses the system clock.
+func (lruCache *LRU[K, V]) SetClock(clock clock.Clock) {
+	lruCache.clock = clock
+}
+
+// Set the time to live of entries added by Put. A non-positive duration means entries added by Put never expire.
+//
+// Entries already in the cache are not affected.
+func (lruCache *LRU[K, V]) SetDefaultTTL(ttl time.Duration) {
+	lruCache.defaultTTL = ttl
+}
+
+// Set the function called with each entry evicted from the cache, either to make room for other entries or because the entry expired.
+//
+// Entries removed explicitly, by Remove, or replaced by Put, are *not* passed to the callback.
+// Passing nil removes any existing callback.
+func (lruCache *LRU[K, V]) SetEvictionCallback(f func(key K, value V, reason EvictionReason)) {
+	lruCache.evictionCallback = f
+}
+
+// ----------------------------------------------------------------------------
+// Helper Methods
+
+// Determine if an entry has expired.
+func (lruCache *LRU[K, V]) isExpired(currentEntry *entry[K, V]) bool {
+	return !currentEntry.expiresAt.IsZero() && !lruCache.clock.Now().Before(currentEntry.expiresAt)
+}
+
+// Remove a node from the cache, returning its entry.
+func (lruCache *LRU[K, V]) removeNode(node *linkedlist.LinkedListNode[*entry[K, V]]) *entry[K, V] {
+	removedEntry := node.Item()
+	lruCache.recency.RemoveNode(node)
+	delete(lruCache.index, removedEntry.key)
+	lruCache.totalCost -= removedEntry.cost
+	return removedEntry
+}
+
+// Remove a node from the cache, recording the eviction and calling the eviction callback.
+func (lruCache *LRU[K, V]) evictNode(node *linkedlist.LinkedListNode[*entry[K, V]], reason EvictionReason) {
+	evictedEntry := lruCache.removeNode(node)
+	switch reason {
+	case EvictionCapacity:
+		lruCache.stats.Evictions += 1
+	case EvictionExpired:
+		lruCache.stats.Expirations += 1
+	}
+
+	if lruCache.evictionCallback != nil {
+		lruCache.evictionCallback(evictedEntry.key, evictedEntry.value, reason)
+	}
+}
+
+// Evict least recently used entries until the total cost is within the capacity.
+func (lruCache *LRU[K, V]) evictToCapacity() {
+	for lruCache.totalCost > lruCache.capacity {
+		lruCache.evictNode(lruCache.recency.Front(), EvictionCapacity)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Get Methods
+
+// Get the number of entries in the cache, including any expired entries not yet removed.
+func (lruCache *LRU[K, V]) Len() int {
+	return len(lruCache.index)
+}
+
+// Get the capacity of the cache, the maximum total cost of all entries.
+func (lruCache *LRU[K, V]) Capacity() int {
+	return lruCache.capacity
+}
+
+// Get the total cost of all entries in the lruCache.
+func (lruCache *LRU[K, V]) Cost() int {
+	return lruCache.totalCost
+}
+
+// Get a snapshot of the counters of the lruCache.
+func (lruCache *LRU[K, V]) Stats() Stats {
+	return lruCache.stats
+}
+
+// Get the value associated with a key, marking the entry as the most recently used.
+// A hit or miss is recorded in the counters of the lruCache.
+//
+// If the entry has expired it is removed, and the eviction callback called.
+//
+// Returns a dsa_error.ErrorItemNotFound if the key is not present, or has expired.
+func (lruCache *LRU[K, V]) Get(key K) (V, error) {
+	node, ok := lruCache.index[key]
+	if !ok {
+		lruCache.stats.Misses += 1
+		return *new(V), dsa_error.ErrorItemNotFound
+	}
+	if lruCache.isExpired(node.Item()) {
+		lruCache.evictNode(node, EvictionExpired)
+		lruCache.stats.Misses += 1
+		return *new(V), dsa_error.ErrorItemNotFound
+	}
+
+	lruCache.stats.Hits += 1
+	lruCache.recency.MoveToBack(node)
+	return node.Item().value, nil
+}
+
+// Get the value associated with a key without marking the entry as used, and without affecting the counters of the lruCache.
+//
+// Returns a dsa_error.ErrorItemNotFound if the key is not present, or has expired.
+func (lruCache *LRU[K, V]) Peek(key K) (V, error) {
+	node, ok := lruCache.index[key]
+	if !ok || lruCache.isExpired(node.Item()) {
+		return *new(V), dsa_error.ErrorItemNotFound
+	}
+
+	return node.Item().value, nil
+}
+
+// Get all keys of the cache, least recently used first. Expired entries not yet removed are included.
+// This method allocates an array of length equal to the number of entries.
+func (lruCache *LRU[K, V]) Keys() []K {
+	keys := make([]K, 0, len(lruCache.index))
+	for _, currentEntry := range lruCache.recency.ForwardIterator() {
+		keys = append(keys, currentEntry.key)
+	}
+	return keys
+}
+
+// ----------------------------------------------------------------------------
+// Add Methods
+
+// Associate a value with a key, marking the entry as the most recently used.
+// The entry expires after the default time to live (see SetDefaultTTL), if one is set.
+//
+// If the key is already present, its value, cost, and expiry are replaced.
+// Least recently used entries are then evicted until the cache is within its capacity.
+//
+// Returns an ErrorInvalidCost if the cost of the entry is negative, or an ErrorCostExceedsCapacity
+// if the cost of the entry alone is larger than the capacity. In either case, the cache is not changed.
+func (lruCache *LRU[K, V]) Put(key K, value V) error {
+	return lruCache.PutWithTTL(key, value, lruCache.defaultTTL)
+}
+
+// Associate a value with a key, as in Put, with the entry expiring after the given time to live.
+// A non-positive time to live means the entry never expires.
+func (lruCache *LRU[K, V]) PutWithTTL(key K, value V, ttl time.Duration) error {
+	cost := lruCache.costFunction(key, value)
+	if cost < 0 {
+		return ErrorInvalidCost
+	}
+	if cost > lruCache.capacity {
+		return ErrorCostExceedsCapacity
+	}
+
+	var expiresAt time.Time
+	if ttl > 0 {
+		expiresAt = lruCache.clock.Now().Add(ttl)
+	}
+
+	if node, ok := lruCache.index[key]; ok {
+		currentEntry := node.Item()
+		lruCache.totalCost += cost - currentEntry.cost
+		currentEntry.value = value
+		currentEntry.cost = cost
+		currentEntry.expiresAt = expiresAt
+		lruCache.recency.MoveToBack(node)
+	} else {
+		lruCache.recency.Add(&entry[K, V]{
+			key:       key,
+			value:     value,
+			cost:      cost,
+			expiresAt: expiresAt,
+		})
+		lruCache.index[key] = lruCache.recency.Back()
+		lruCache.totalCost += cost
+	}
+
+	lruCache.evictToCapacity()
+	return nil
+}
+
+// Change the capacity of the lruCache.
+//
+// If the total cost of the entries exceeds the new capacity, least recently used entries are evicted until the cache fits.
+// The evicted entries are passed to the eviction callback (if set).
+//
+// Returns a dsa_error.ErrorInvalidCapacity if the new capacity is not positive, in which case the cache is not changed.
+func (lruCache *LRU[K, V]) Resize(capacity int) error {
+	if capacity <= 0 {
+		return dsa_error.ErrorInvalidCapacity
+	}
+
+	lruCache.capacity = capacity
+	lruCache.evictToCapacity()
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Remove Methods
+
+// Remove a key from the cache, returning its value. The removed entry is *not* passed to the eviction callback.
+//
+// Returns a dsa_error.ErrorItemNotFound if the key is not present, or has expired.
+func (lruCache *LRU[K, V]) Remove(key K) (V, error) {
+	node, ok := lruCache.index[key]
+	if !ok {
+		return *new(V), dsa_error.ErrorItemNotFound
+	}
+	if lruCache.isExpired(node.Item()) {
+		lruCache.evictNode(node, EvictionExpired)
+		return *new(V), dsa_error.ErrorItemNotFound
+	}
+
+	return lruCache.removeNode(node).value, nil
+}
+
+// Remove all expired entries from the cache, passing each to the eviction callback (if set).
+// Returns the number of entries removed.
+//
+// This method takes time linear in the number of entries.
+func (lruCache *LRU[K, V]) PurgeExpired() int {
+	numPurged := 0
+	node := lruCache.recency.Front()
+	for node != nil {
+		nextNode := node.Next()
+		if lruCache.isExpired(node.Item()) {
+			lruCache.evictNode(node, EvictionExpired)
+			numPurged += 1
+		}
+		node = nextNode
+	}
+	return numPurged
+}
+
+// Remove all entries from the lruCache. The removed entries are *not* passed to the eviction callback.
+// The counters of the cache are not reset.
+func (lruCache *LRU[K, V]) Clear() {
+	lruCache.index = make(map[K]*linkedlist.LinkedListNode[*entry[K, V]])
+	lruCache.recency = linkedlist.New[*entry[K, V]]()
+	lruCache.totalCost = 0
+}
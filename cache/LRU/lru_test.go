@@ -0,0 +1,289 @@
+package lru_test
+
+import (
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"testing"
+	"time"
+
+	lru "github.com/hmcalister/Go-DSA/cache/LRU"
+	clock "github.com/hmcalister/Go-DSA/utils/Clock"
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
+)
+
+// a helper method to check the keys of a cache are exactly the expected keys, least recently used first.
+//
+// calls t.Errorf if the keys do not match.
+func checkLRUKeys(t *testing.T, cache *lru.LRU[int, int], expectedKeys []int) {
+	t.Helper()
+
+	keys := cache.Keys()
+	if !slices.Equal(keys, expectedKeys) {
+		t.Errorf("found keys (%v) does not match expected keys (%v)", keys, expectedKeys)
+	}
+	if cache.Len() != len(expectedKeys) {
+		t.Errorf("found length (%v) does not match expected length (%v)", cache.Len(), len(expectedKeys))
+	}
+}
+
+func TestLRUInit(t *testing.T) {
+	t.Run("int", func(t *testing.T) {
+		lru.New[int, int](10)
+	})
+	t.Run("string", func(t *testing.T) {
+		lru.New[string, []byte](10)
+	})
+	t.Run("cost", func(t *testing.T) {
+		lru.NewWithCost(10, func(key string, value []byte) int { return len(value) })
+	})
+	t.Run("invalid capacity", func(t *testing.T) {
+		if _, err := lru.New[int, int](0); !errors.Is(err, dsa_error.ErrorInvalidCapacity) {
+			t.Errorf("expected ErrorInvalidCapacity for zero capacity, found (%v)", err)
+		}
+		if _, err := lru.NewWithCost(-1, func(key int, value int) int { return value }); !errors.Is(err, dsa_error.ErrorInvalidCapacity) {
+			t.Errorf("expected ErrorInvalidCapacity for negative capacity, found (%v)", err)
+		}
+	})
+}
+
+func TestLRUGetPut(t *testing.T) {
+	cache, _ := lru.New[int, int](3)
+	cache.Put(1, 10)
+	cache.Put(2, 20)
+	cache.Put(3, 30)
+	checkLRUKeys(t, cache, []int{1, 2, 3})
+
+	// Get marks the entry as most recently used
+	if value, err := cache.Get(1); err != nil || value != 10 {
+		t.Errorf("found value (%v, %v) does not match expected value (%v, %v)", value, err, 10, nil)
+	}
+	checkLRUKeys(t, cache, []int{2, 3, 1})
+
+	// Adding a fourth entry evicts the least recently used
+	cache.Put(4, 40)
+	checkLRUKeys(t, cache, []int{3, 1, 4})
+	if _, err := cache.Get(2); !errors.Is(err, dsa_error.ErrorItemNotFound) {
+		t.Errorf("expected ErrorItemNotFound for evicted key, found (%v)", err)
+	}
+
+	// Updating an entry replaces the value and marks it as most recently used
+	cache.Put(3, 31)
+	checkLRUKeys(t, cache, []int{1, 4, 3})
+	if value, _ := cache.Get(3); value != 31 {
+		t.Errorf("found value (%v) does not match expected value (%v)", value, 31)
+	}
+}
+
+func TestLRUPeekRemove(t *testing.T) {
+	lruCache, _ := lru.New[int, int](3)
+	lruCache.Put(1, 10)
+	lruCache.Put(2, 20)
+
+	// Peek does not change recency or counters
+	if value, err := lruCache.Peek(1); err != nil || value != 10 {
+		t.Errorf("found peeked value (%v, %v) does not match expected value (%v, %v)", value, err, 10, nil)
+	}
+	if _, err := lruCache.Peek(3); !errors.Is(err, dsa_error.ErrorItemNotFound) {
+		t.Errorf("expected ErrorItemNotFound when peeking missing key, found (%v)", err)
+	}
+	checkLRUKeys(t, lruCache, []int{1, 2})
+	if stats := lruCache.Stats(); stats != (lru.Stats{}) {
+		t.Errorf("found stats (%+v) does not match expected stats (%+v)", stats, lru.Stats{})
+	}
+
+	if value, err := lruCache.Remove(1); err != nil || value != 10 {
+		t.Errorf("found removed value (%v, %v) does not match expected value (%v, %v)", value, err, 10, nil)
+	}
+	if _, err := lruCache.Remove(1); !errors.Is(err, dsa_error.ErrorItemNotFound) {
+		t.Errorf("expected ErrorItemNotFound when removing missing key, found (%v)", err)
+	}
+	checkLRUKeys(t, lruCache, []int{2})
+
+	lruCache.Clear()
+	checkLRUKeys(t, lruCache, []int{})
+	if lruCache.Cost() != 0 {
+		t.Errorf("found cost (%v) does not match expected cost (%v)", lruCache.Cost(), 0)
+	}
+}
+
+func TestLRUCostFunction(t *testing.T) {
+	cache, _ := lru.NewWithCost(10, func(key int, value int) int { return value })
+	cache.Put(1, 4)
+	cache.Put(2, 4)
+	if cache.Cost() != 8 {
+		t.Errorf("found cost (%v) does not match expected cost (%v)", cache.Cost(), 8)
+	}
+
+	// Adding an entry of cost 5 must evict the first entry only
+	cache.Put(3, 5)
+	checkLRUKeys(t, cache, []int{2, 3})
+	if cache.Cost() != 9 {
+		t.Errorf("found cost (%v) does not match expected cost (%v)", cache.Cost(), 9)
+	}
+
+	// Growing an existing entry may evict others
+	cache.Put(3, 8)
+	checkLRUKeys(t, cache, []int{3})
+
+	if err := cache.Put(4, 11); !errors.Is(err, lru.ErrorCostExceedsCapacity) {
+		t.Errorf("expected ErrorCostExceedsCapacity for entry larger than capacity, found (%v)", err)
+	}
+	if err := cache.Put(4, -1); !errors.Is(err, lru.ErrorInvalidCost) {
+		t.Errorf("expected ErrorInvalidCost for negative cost, found (%v)", err)
+	}
+	checkLRUKeys(t, cache, []int{3})
+}
+
+func TestLRUResize(t *testing.T) {
+	cache, _ := lru.New[int, int](5)
+	evictedKeys := make([]int, 0)
+	cache.SetEvictionCallback(func(key int, value int, reason lru.EvictionReason) {
+		if reason != lru.EvictionCapacity {
+			t.Errorf("found eviction reason (%v) does not match expected reason (%v)", reason, lru.EvictionCapacity)
+		}
+		evictedKeys = append(evictedKeys, key)
+	})
+	for key := range 5 {
+		cache.Put(key, key)
+	}
+
+	if err := cache.Resize(2); err != nil {
+		t.Errorf("error when resizing: %v", err)
+	}
+	checkLRUKeys(t, cache, []int{3, 4})
+	if !slices.Equal(evictedKeys, []int{0, 1, 2}) {
+		t.Errorf("found evicted keys (%v) does not match expected keys (%v)", evictedKeys, []int{0, 1, 2})
+	}
+	if err := cache.Resize(0); !errors.Is(err, dsa_error.ErrorInvalidCapacity) {
+		t.Errorf("expected ErrorInvalidCapacity when resizing to zero, found (%v)", err)
+	}
+	if cache.Capacity() != 2 {
+		t.Errorf("found capacity (%v) does not match expected capacity (%v)", cache.Capacity(), 2)
+	}
+
+	// Removed entries are not passed to the callback
+	cache.Remove(3)
+	if len(evictedKeys) != 3 {
+		t.Errorf("expected removed entry to not be passed to eviction callback")
+	}
+}
+
+func TestLRUTTL(t *testing.T) {
+	manualClock := clock.NewManualClock(time.Unix(0, 0))
+	cache, _ := lru.New[int, int](10)
+	cache.SetClock(manualClock)
+	cache.SetDefaultTTL(time.Minute)
+
+	expiredKeys := make([]int, 0)
+	cache.SetEvictionCallback(func(key int, value int, reason lru.EvictionReason) {
+		if reason == lru.EvictionExpired {
+			expiredKeys = append(expiredKeys, key)
+		}
+	})
+
+	cache.Put(1, 10)
+	cache.PutWithTTL(2, 20, 2*time.Minute)
+	cache.PutWithTTL(3, 30, 0)
+
+	manualClock.Advance(time.Minute)
+	if _, err := cache.Get(1); !errors.Is(err, dsa_error.ErrorItemNotFound) {
+		t.Errorf("expected ErrorItemNotFound for expired key, found (%v)", err)
+	}
+	if value, err := cache.Get(2); err != nil || value != 20 {
+		t.Errorf("found value (%v, %v) does not match expected value (%v, %v)", value, err, 20, nil)
+	}
+
+	// Peek reports expired entries as missing without removing them
+	manualClock.Advance(time.Minute)
+	if _, err := cache.Peek(2); !errors.Is(err, dsa_error.ErrorItemNotFound) {
+		t.Errorf("expected ErrorItemNotFound when peeking expired key, found (%v)", err)
+	}
+	checkLRUKeys(t, cache, []int{3, 2})
+
+	manualClock.Advance(time.Hour)
+	if numPurged := cache.PurgeExpired(); numPurged != 1 {
+		t.Errorf("found number purged (%v) does not match expected number (%v)", numPurged, 1)
+	}
+	checkLRUKeys(t, cache, []int{3})
+	if !slices.Equal(expiredKeys, []int{1, 2}) {
+		t.Errorf("found expired keys (%v) does not match expected keys (%v)", expiredKeys, []int{1, 2})
+	}
+
+	// Updating an entry resets its expiry
+	cache.Put(3, 31)
+	manualClock.Advance(30 * time.Second)
+	cache.Put(3, 32)
+	manualClock.Advance(45 * time.Second)
+	if value, err := cache.Get(3); err != nil || value != 32 {
+		t.Errorf("found value (%v, %v) does not match expected value (%v, %v)", value, err, 32, nil)
+	}
+}
+
+func TestLRUStats(t *testing.T) {
+	manualClock := clock.NewManualClock(time.Unix(0, 0))
+	lruCache, _ := lru.New[int, int](2)
+	lruCache.SetClock(manualClock)
+
+	lruCache.Put(1, 10)
+	lruCache.Get(1)
+	lruCache.Get(2)
+	lruCache.Put(2, 20)
+	lruCache.Put(3, 30)
+	lruCache.PutWithTTL(4, 40, time.Second)
+	manualClock.Advance(time.Second)
+	lruCache.Get(4)
+
+	expectedStats := lru.Stats{
+		Hits:        1,
+		Misses:      2,
+		Evictions:   2,
+		Expirations: 1,
+	}
+	if stats := lruCache.Stats(); stats != expectedStats {
+		t.Errorf("found stats (%+v) does not match expected stats (%+v)", stats, expectedStats)
+	}
+}
+
+// The cache must agree with a simple slice based reference implementation.
+func TestLRURandomOperations(t *testing.T) {
+	randomGenerator := rand.New(rand.NewPCG(0, 0))
+	capacity := 8
+	cache, _ := lru.New[int, int](capacity)
+	expectedKeys := make([]int, 0)
+	expectedValues := make(map[int]int)
+	touch := func(key int) {
+		if index := slices.Index(expectedKeys, key); index != -1 {
+			expectedKeys = slices.Delete(expectedKeys, index, index+1)
+		}
+		expectedKeys = append(expectedKeys, key)
+	}
+
+	for step := range 5000 {
+		key := randomGenerator.IntN(20)
+		switch randomGenerator.IntN(3) {
+		case 0:
+			cache.Put(key, step)
+			touch(key)
+			expectedValues[key] = step
+			if len(expectedKeys) > capacity {
+				delete(expectedValues, expectedKeys[0])
+				expectedKeys = expectedKeys[1:]
+			}
+		case 1:
+			value, err := cache.Get(key)
+			expectedValue, expectedPresent := expectedValues[key]
+			if (err == nil) != expectedPresent || value != expectedValue {
+				t.Fatalf("step %v: found value (%v, %v) for key (%v) does not match expected value (%v, %v)", step, value, err, key, expectedValue, expectedPresent)
+			}
+			if expectedPresent {
+				touch(key)
+			}
+		case 2:
+			cache.Remove(key)
+			expectedKeys = slices.DeleteFunc(expectedKeys, func(item int) bool { return item == key })
+			delete(expectedValues, key)
+		}
+	}
+	checkLRUKeys(t, cache, expectedKeys)
+}
@@ -0,0 +1,7 @@
+package twoq
+
+import "errors"
+
+var (
+	ErrorInvalidQueueSize = errors.New("queue size must be positive and at most the capacity")
+)
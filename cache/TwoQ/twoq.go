@@ -0,0 +1,188 @@
+package twoq
+
+import (
+	cache "github.com/hmcalister/Go-DSA/cache/Cache"
+	linkedhashmap "github.com/hmcalister/Go-DSA/map/LinkedHashMap"
+	linkedhashset "github.com/hmcalister/Go-DSA/set/LinkedHashSet"
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
+)
+
+// Implement a 2Q cache, satisfying the github.com/hmcalister/Go-DSA/cache/Cache interface.
+//
+// 2Q (Johnson and Shasha, 1994) admits new entries to a small first-in first-out queue, A1in.
+// Entries evicted from A1in have their keys remembered, without their values, in the ghost queue A1out.
+// Only a key put again while remembered in A1out is promoted to the main LRU queue, Am.
+// Hence keys used once, such as those of a scan, pass through A1in without disturbing the entries of Am.
+//
+// Each queue is a github.com/hmcalister/Go-DSA/map/LinkedHashMap or github.com/hmcalister/Go-DSA/set/LinkedHashSet,
+// so Get, Put, and Remove are all O(1).
+type TwoQ[K comparable, V any] struct {
+	a1in  *linkedhashmap.LinkedHashMap[K, V]
+	a1out *linkedhashset.LinkedHashSet[K]
+	am    *linkedhashmap.LinkedHashMap[K, V]
+
+	capacity int
+
+	// The size A1in may grow to before its entries are evicted in preference to those of Am.
+	a1inSize int
+
+	// The maximum number of keys remembered by A1out.
+	a1outSize int
+
+	stats cache.Stats
+}
+
+// Create a new 2Q cache holding at most capacity entries.
+// A1in is allowed a quarter of the capacity, and A1out remembers half the capacity in keys, as suggested by the authors of 2Q.
+//
+// Returns a dsa_error.ErrorInvalidCapacity if the capacity is not positive.
+func New[K comparable, V any](capacity int) (*TwoQ[K, V], error) {
+	return NewWithQueueSizes[K, V](capacity, max(1, capacity/4), max(1, capacity/2))
+}
+
+// Create a new 2Q cache holding at most capacity entries, where A1in may hold a1inSize entries
+// before its entries are evicted in preference to those of Am, and A1out remembers at most a1outSize keys.
+//
+// Returns a dsa_error.ErrorInvalidCapacity if the capacity is not positive,
+// or an ErrorInvalidQueueSize if either queue size is not positive or is larger than the capacity.
+func NewWithQueueSizes[K comparable, V any](capacity int, a1inSize int, a1outSize int) (*TwoQ[K, V], error) {
+	if capacity <= 0 {
+		return nil, dsa_error.ErrorInvalidCapacity
+	}
+	if a1inSize <= 0 || a1inSize > capacity || a1outSize <= 0 || a1outSize > capacity {
+		return nil, ErrorInvalidQueueSize
+	}
+
+	return &TwoQ[K, V]{
+		a1in:      linkedhashmap.New[K, V](linkedhashmap.InsertionOrder),
+		a1out:     linkedhashset.New[K](linkedhashset.InsertionOrder),
+		am:        linkedhashmap.New[K, V](linkedhashmap.AccessOrder),
+		capacity:  capacity,
+		a1inSize:  a1inSize,
+		a1outSize: a1outSize,
+	}, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helper Methods
+
+// Evict an entry if the cache is full, to make room for a new entry.
+//
+// The oldest entry of A1in is evicted if A1in is larger than its allowed size (or Am is empty), and its key remembered in A1out.
+// Otherwise the least recently used entry of Am is evicted.
+func (twoQCache *TwoQ[K, V]) reclaim() {
+	if twoQCache.Len() < twoQCache.capacity {
+		return
+	}
+
+	if twoQCache.a1in.Size() > twoQCache.a1inSize || twoQCache.am.Size() == 0 {
+		key, _, _ := twoQCache.a1in.RemoveOldest()
+		twoQCache.a1out.Add(key)
+		if twoQCache.a1out.Size() > twoQCache.a1outSize {
+			twoQCache.a1out.RemoveOldest()
+		}
+	} else {
+		twoQCache.am.RemoveOldest()
+	}
+	twoQCache.stats.Evictions += 1
+}
+
+// ----------------------------------------------------------------------------
+// Get Methods
+
+// Get the number of entries in the twoQCache. Keys remembered by A1out are not counted.
+func (twoQCache *TwoQ[K, V]) Len() int {
+	return twoQCache.a1in.Size() + twoQCache.am.Size()
+}
+
+// Get the capacity of the cache, the maximum number of entries.
+func (twoQCache *TwoQ[K, V]) Capacity() int {
+	return twoQCache.capacity
+}
+
+// Get a snapshot of the counters of the twoQCache.
+func (twoQCache *TwoQ[K, V]) Stats() cache.Stats {
+	return twoQCache.stats
+}
+
+// Get the value associated with a key. A hit or miss is recorded in the counters of the twoQCache.
+//
+// An entry of Am is marked as the most recently used. An entry of A1in is *not* moved,
+// as 2Q treats repeated accesses shortly after admission as correlated, rather than as evidence of popularity.
+//
+// Returns a dsa_error.ErrorItemNotFound if the key is not present.
+func (twoQCache *TwoQ[K, V]) Get(key K) (V, error) {
+	if value, err := twoQCache.am.Get(key); err == nil {
+		twoQCache.stats.Hits += 1
+		return value, nil
+	}
+	if value, err := twoQCache.a1in.Peek(key); err == nil {
+		twoQCache.stats.Hits += 1
+		return value, nil
+	}
+
+	twoQCache.stats.Misses += 1
+	return *new(V), dsa_error.ErrorItemNotFound
+}
+
+// Get the value associated with a key without moving the entry, and without affecting the counters of the twoQCache.
+//
+// Returns a dsa_error.ErrorItemNotFound if the key is not present.
+func (twoQCache *TwoQ[K, V]) Peek(key K) (V, error) {
+	if value, err := twoQCache.am.Peek(key); err == nil {
+		return value, nil
+	}
+	return twoQCache.a1in.Peek(key)
+}
+
+// ----------------------------------------------------------------------------
+// Add Methods
+
+// Associate a value with a key.
+//
+// If the key is already present, its value is replaced, and an entry of Am is marked as the most recently used.
+// If the key is remembered by A1out, the entry is added to Am. Otherwise, the entry is added to A1in.
+// In either of the latter cases an entry may be evicted to make room.
+//
+// This method always returns nil, and returns an error only to satisfy the Cache interface.
+func (twoQCache *TwoQ[K, V]) Put(key K, value V) error {
+	if twoQCache.am.ContainsKey(key) {
+		twoQCache.am.Put(key, value)
+		return nil
+	}
+	if twoQCache.a1in.ContainsKey(key) {
+		twoQCache.a1in.Put(key, value)
+		return nil
+	}
+
+	twoQCache.reclaim()
+	if twoQCache.a1out.Contains(key) {
+		twoQCache.a1out.Remove(key)
+		twoQCache.am.Put(key, value)
+	} else {
+		twoQCache.a1in.Put(key, value)
+	}
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Remove Methods
+
+// Remove a key from the cache, returning its value. The key is also forgotten by A1out.
+//
+// Returns a dsa_error.ErrorItemNotFound if the key is not present.
+func (twoQCache *TwoQ[K, V]) Remove(key K) (V, error) {
+	twoQCache.a1out.Remove(key)
+
+	if value, err := twoQCache.am.Remove(key); err == nil {
+		return value, nil
+	}
+	return twoQCache.a1in.Remove(key)
+}
+
+// Remove all entries and remembered keys from the twoQCache. The counters of the cache are not reset.
+func (twoQCache *TwoQ[K, V]) Clear() {
+	twoQCache.a1in.Clear()
+	twoQCache.a1out = linkedhashset.New[K](linkedhashset.InsertionOrder)
+	twoQCache.am.Clear()
+}
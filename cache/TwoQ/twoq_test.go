@@ -0,0 +1,122 @@
+package twoq_test
+
+import (
+	"errors"
+	"testing"
+
+	cache "github.com/hmcalister/Go-DSA/cache/Cache"
+	twoq "github.com/hmcalister/Go-DSA/cache/TwoQ"
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
+)
+
+// a helper method to check exactly which keys are present in a cache, without affecting the cache.
+//
+// calls t.Errorf if a key is present when it should not be, or missing when it should be present.
+func checkTwoQKeys(t *testing.T, twoQCache *twoq.TwoQ[int, int], presentKeys []int, absentKeys []int) {
+	t.Helper()
+
+	for _, key := range presentKeys {
+		if _, err := twoQCache.Peek(key); err != nil {
+			t.Errorf("expected key (%v) to be present, found (%v)", key, err)
+		}
+	}
+	for _, key := range absentKeys {
+		if _, err := twoQCache.Peek(key); !errors.Is(err, dsa_error.ErrorItemNotFound) {
+			t.Errorf("expected ErrorItemNotFound for absent key (%v), found (%v)", key, err)
+		}
+	}
+	if twoQCache.Len() != len(presentKeys) {
+		t.Errorf("found length (%v) does not match expected length (%v)", twoQCache.Len(), len(presentKeys))
+	}
+}
+
+func TestTwoQInit(t *testing.T) {
+	t.Run("int", func(t *testing.T) {
+		twoq.New[int, int](10)
+	})
+	t.Run("string", func(t *testing.T) {
+		twoq.New[string, []byte](10)
+	})
+	t.Run("capacity one", func(t *testing.T) {
+		twoQCache, _ := twoq.New[int, int](1)
+		twoQCache.Put(1, 10)
+		twoQCache.Put(2, 20)
+		checkTwoQKeys(t, twoQCache, []int{2}, []int{1})
+	})
+	t.Run("invalid capacity", func(t *testing.T) {
+		_, err := twoq.New[int, int](0)
+		if !errors.Is(err, dsa_error.ErrorInvalidCapacity) {
+			t.Errorf("did not encounter expected error (%v) when creating cache with invalid capacity, found %v", dsa_error.ErrorInvalidCapacity, err)
+		}
+	})
+	t.Run("invalid queue size", func(t *testing.T) {
+		_, err := twoq.NewWithQueueSizes[int, int](4, 5, 2)
+		if !errors.Is(err, twoq.ErrorInvalidQueueSize) {
+			t.Errorf("did not encounter expected error (%v) when creating cache with invalid queue size, found %v", twoq.ErrorInvalidQueueSize, err)
+		}
+	})
+}
+
+func TestTwoQPromotion(t *testing.T) {
+	twoQCache, _ := twoq.NewWithQueueSizes[int, int](4, 1, 2)
+	for key := 1; key <= 5; key += 1 {
+		twoQCache.Put(key, key*10)
+	}
+	checkTwoQKeys(t, twoQCache, []int{2, 3, 4, 5}, []int{1})
+
+	// Key 1 is remembered by A1out, so is promoted to Am when put again
+	twoQCache.Put(1, 11)
+	checkTwoQKeys(t, twoQCache, []int{1, 3, 4, 5}, []int{2})
+
+	// Keys used once never displace the promoted key
+	for key := 100; key < 200; key += 1 {
+		twoQCache.Put(key, key)
+	}
+	checkTwoQKeys(t, twoQCache, []int{1, 197, 198, 199}, []int{3, 100})
+	if value, err := twoQCache.Get(1); err != nil || value != 11 {
+		t.Errorf("found value (%v, %v) does not match expected value (%v, %v)", value, err, 11, nil)
+	}
+}
+
+func TestTwoQRemove(t *testing.T) {
+	twoQCache, _ := twoq.NewWithQueueSizes[int, int](2, 1, 1)
+	twoQCache.Put(1, 10)
+	twoQCache.Put(2, 20)
+	twoQCache.Put(3, 30)
+
+	if value, err := twoQCache.Remove(2); err != nil || value != 20 {
+		t.Errorf("found removed value (%v, %v) does not match expected value (%v, %v)", value, err, 20, nil)
+	}
+	if _, err := twoQCache.Remove(2); !errors.Is(err, dsa_error.ErrorItemNotFound) {
+		t.Errorf("expected ErrorItemNotFound when removing missing key, found (%v)", err)
+	}
+
+	// Removing a key remembered by A1out forgets it, so it is not promoted when put again
+	if _, err := twoQCache.Remove(1); !errors.Is(err, dsa_error.ErrorItemNotFound) {
+		t.Errorf("expected ErrorItemNotFound when removing remembered key, found (%v)", err)
+	}
+	twoQCache.Put(1, 11)
+	twoQCache.Put(4, 40)
+	checkTwoQKeys(t, twoQCache, []int{1, 4}, []int{2, 3})
+
+	twoQCache.Clear()
+	checkTwoQKeys(t, twoQCache, []int{}, []int{1, 4})
+}
+
+func TestTwoQStats(t *testing.T) {
+	twoQCache, _ := twoq.New[int, int](2)
+	twoQCache.Put(1, 10)
+	twoQCache.Get(1)
+	twoQCache.Get(2)
+	twoQCache.Put(2, 20)
+	twoQCache.Put(3, 30)
+
+	expectedStats := cache.Stats{
+		Hits:      1,
+		Misses:    1,
+		Evictions: 1,
+	}
+	if stats := twoQCache.Stats(); stats != expectedStats {
+		t.Errorf("found stats (%+v) does not match expected stats (%+v)", stats, expectedStats)
+	}
+}
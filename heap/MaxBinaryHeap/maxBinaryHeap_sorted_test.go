@@ -0,0 +1,124 @@
+package maxbinaryheap_test
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	maxbinaryheap "github.com/hmcalister/Go-DSA/heap/MaxBinaryHeap"
+	comparator "github.com/hmcalister/Go-DSA/utils/Comparator"
+)
+
+func TestMaxBinaryHeapIteratorSorted(t *testing.T) {
+	heap := maxbinaryheap.New[int](comparator.DefaultIntegerComparator)
+
+	numItems := 100
+	items := make([]int, numItems)
+	for i := range numItems {
+		items[i] = i % 10
+	}
+	rand.Shuffle(numItems, func(i, j int) {
+		items[i], items[j] = items[j], items[i]
+	})
+	for _, item := range items {
+		heap.Add(item)
+	}
+	heapItemsBefore := heap.Items()
+
+	foundOrder := make([]int, 0)
+	for item := range heap.IteratorSorted() {
+		foundOrder = append(foundOrder, item)
+	}
+
+	expectedOrder := slices.Clone(items)
+	slices.Sort(expectedOrder)
+	slices.Reverse(expectedOrder)
+	if !slices.Equal(expectedOrder, foundOrder) {
+		t.Errorf("expected order %v does not match found order %v", expectedOrder, foundOrder)
+	}
+
+	if !slices.Equal(heapItemsBefore, heap.Items()) {
+		t.Errorf("heap items were modified by sorted iteration")
+	}
+}
+
+func TestMaxBinaryHeapIteratorSortedEarlyBreak(t *testing.T) {
+	heap := maxbinaryheap.New[int](comparator.DefaultIntegerComparator)
+	items := []int{1, 2, 3, 4, 5, 6, 7, 8, 9}
+	for _, item := range items {
+		heap.Add(item)
+	}
+
+	foundOrder := make([]int, 0)
+	for item := range heap.IteratorSorted() {
+		if item < 7 {
+			break
+		}
+		foundOrder = append(foundOrder, item)
+	}
+
+	expectedOrder := []int{9, 8, 7}
+	if !slices.Equal(expectedOrder, foundOrder) {
+		t.Errorf("expected order %v does not match found order %v", expectedOrder, foundOrder)
+	}
+}
+
+func TestMaxBinaryHeapIteratorSortedEmpty(t *testing.T) {
+	heap := maxbinaryheap.New[int](comparator.DefaultIntegerComparator)
+
+	for item := range heap.IteratorSorted() {
+		t.Errorf("found item %v when iterating over empty heap", item)
+	}
+}
+
+func TestMaxBinaryHeapTopK(t *testing.T) {
+	heap := maxbinaryheap.New[int](comparator.DefaultIntegerComparator)
+	items := []int{5, 3, 9, 1, 7, 2, 8, 4, 6}
+	for _, item := range items {
+		heap.Add(item)
+	}
+
+	testCases := []struct {
+		k             int
+		expectedItems []int
+	}{
+		{-1, []int{}},
+		{0, []int{}},
+		{1, []int{9}},
+		{4, []int{9, 8, 7, 6}},
+		{9, []int{9, 8, 7, 6, 5, 4, 3, 2, 1}},
+		{20, []int{9, 8, 7, 6, 5, 4, 3, 2, 1}},
+	}
+
+	for _, testCase := range testCases {
+		foundItems := heap.TopK(testCase.k)
+		if !slices.Equal(testCase.expectedItems, foundItems) {
+			t.Errorf("TopK(%v): expected items %v does not match found items %v", testCase.k, testCase.expectedItems, foundItems)
+		}
+	}
+
+	if heap.Size() != len(items) {
+		t.Errorf("heap size (%v) does not match expected size (%v) after TopK", heap.Size(), len(items))
+	}
+}
+
+func TestMaxBinaryHeapNSmallestNLargest(t *testing.T) {
+	items := []int{5, 3, 9, 1, 7, 2, 8, 4, 6}
+	itemsBefore := slices.Clone(items)
+
+	foundSmallest := maxbinaryheap.NSmallest(items, 3, comparator.DefaultIntegerComparator)
+	expectedSmallest := []int{1, 2, 3}
+	if !slices.Equal(expectedSmallest, foundSmallest) {
+		t.Errorf("expected smallest items %v does not match found items %v", expectedSmallest, foundSmallest)
+	}
+
+	foundLargest := maxbinaryheap.NLargest(items, 3, comparator.DefaultIntegerComparator)
+	expectedLargest := []int{9, 8, 7}
+	if !slices.Equal(expectedLargest, foundLargest) {
+		t.Errorf("expected largest items %v does not match found items %v", expectedLargest, foundLargest)
+	}
+
+	if !slices.Equal(itemsBefore, items) {
+		t.Errorf("input slice was modified, expected %v found %v", itemsBefore, items)
+	}
+}
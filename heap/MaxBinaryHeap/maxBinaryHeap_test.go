@@ -114,6 +114,32 @@ func TestMaxHeapAddRandomOrder(t *testing.T) {
 	}
 }
 
+func TestMaxHeapNewFromSlice(t *testing.T) {
+	numItems := 100
+	items := make([]int, numItems)
+	for i := range numItems {
+		items[i] = i
+	}
+	rand.Shuffle(numItems, func(i, j int) {
+		items[i], items[j] = items[j], items[i]
+	})
+	originalItems := slices.Clone(items)
+
+	heap := maxbinaryheap.NewFromSlice(items, comparator.DefaultIntegerComparator)
+	if !slices.Equal(items, originalItems) {
+		t.Errorf("creating heap from slice modified the slice")
+	}
+
+	expectedItems := slices.Sorted(slices.Values(items))
+	slices.Reverse(expectedItems)
+	for _, expectedItem := range expectedItems {
+		item, err := heap.RemoveMax()
+		if err != nil || item != expectedItem {
+			t.Errorf("found removed item (%v, %v) does not match expected item (%v, %v)", item, err, expectedItem, nil)
+		}
+	}
+}
+
 // ----------------------------------------------------------------------------
 // Remove Tests
 
@@ -2,6 +2,7 @@ package minbinaryheap
 
 import (
 	"iter"
+	"slices"
 
 	comparator "github.com/hmcalister/Go-DSA/utils/Comparator"
 	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
@@ -30,6 +31,20 @@ func New[T any](comparatorFunction comparator.ComparatorFunction[T]) *MinBinaryH
 	}
 }
 
+// Create a new Min-BinaryHeap containing the given items, with comparator given by the comparatorFunction.
+//
+// The items are copied and heapified once, which takes linear time, rather than being added one at a time.
+func NewFromSlice[T any](items []T, comparatorFunction comparator.ComparatorFunction[T]) *MinBinaryHeap[T] {
+	heap := &MinBinaryHeap[T]{
+		heapData:           slices.Clone(items),
+		comparatorFunction: comparatorFunction,
+	}
+	for index := len(heap.heapData)/2 - 1; index >= 0; index -= 1 {
+		heap.minHeapify(index)
+	}
+	return heap
+}
+
 // ----------------------------------------------------------------------------
 // Heap Helper Methods
 
@@ -260,8 +275,7 @@ func Fold[T any, G any](heap *MinBinaryHeap[T], initialAccumulator G, f func(ite
 
 // Iterate over the items of the heap.
 // In case it matters, the iteration is effectively in "reading order" along the heap.
-// This is *not* a sorted order. To iterate in sorted order you may either extract the heap items with Items() and sort,
-// or continually pop items from the heap (which will naturally update the heap).
+// This is *not* a sorted order. To iterate in sorted order without modifying the heap, use IteratorSorted().
 //
 // If you are updating items in the heap, please note this method does *not* reheapify.
 //
@@ -276,3 +290,159 @@ func (heap *MinBinaryHeap[T]) Iterator() iter.Seq[T] {
 		}
 	}
 }
+
+// ----------------------------------------------------------------------------
+// Sorted iteration methods
+//
+// Methods to walk the heap in priority order *without* removing items from the heap.
+
+// A small min-heap of indices into heapData, ordered by the items at those indices.
+//
+// This is used as the frontier when walking the heap in sorted order. Since the items in heapData
+// already satisfy the heap property, the next smallest item is always either the root or the child
+// of an item that has already been visited. Hence the frontier never contains more than k+1 indices
+// after k items have been visited, giving O(k log k) (at most O(k log n)) time to visit the first k items.
+type indexHeap[T any] struct {
+	indices []int
+	heap    *MinBinaryHeap[T]
+}
+
+// Determine if the item at frontier position a is less than the item at frontier position b.
+func (frontier *indexHeap[T]) less(a, b int) bool {
+	return frontier.heap.comparatorFunction(frontier.heap.heapData[frontier.indices[a]], frontier.heap.heapData[frontier.indices[b]]) < 0
+}
+
+// Add a new index to the frontier, sifting up to restore heap order.
+func (frontier *indexHeap[T]) push(index int) {
+	frontier.indices = append(frontier.indices, index)
+	currentIndex := len(frontier.indices) - 1
+	for currentIndex > 0 {
+		parentIndex := (currentIndex - 1) / 2
+		if !frontier.less(currentIndex, parentIndex) {
+			break
+		}
+		frontier.indices[parentIndex], frontier.indices[currentIndex] = frontier.indices[currentIndex], frontier.indices[parentIndex]
+		currentIndex = parentIndex
+	}
+}
+
+// Remove and return the index of the smallest item in the frontier, sifting down to restore heap order.
+//
+// Must not be called on an empty frontier.
+func (frontier *indexHeap[T]) pop() int {
+	minIndex := frontier.indices[0]
+	lastIndex := len(frontier.indices) - 1
+	frontier.indices[0] = frontier.indices[lastIndex]
+	frontier.indices = frontier.indices[:lastIndex]
+
+	currentIndex := 0
+	for {
+		leftIndex := 2*currentIndex + 1
+		rightIndex := 2*currentIndex + 2
+		smallestIndex := currentIndex
+		if leftIndex < len(frontier.indices) && frontier.less(leftIndex, smallestIndex) {
+			smallestIndex = leftIndex
+		}
+		if rightIndex < len(frontier.indices) && frontier.less(rightIndex, smallestIndex) {
+			smallestIndex = rightIndex
+		}
+		if smallestIndex == currentIndex {
+			break
+		}
+		frontier.indices[currentIndex], frontier.indices[smallestIndex] = frontier.indices[smallestIndex], frontier.indices[currentIndex]
+		currentIndex = smallestIndex
+	}
+
+	return minIndex
+}
+
+// Iterate over the items of the heap in sorted order, smallest item first.
+//
+// Unlike continually calling RemoveMin(), this method does *not* modify the heap.
+// Items are produced lazily, and visiting the first k items takes O(k log n) time
+// (with O(k) additional memory), so breaking out of the iteration early is cheap.
+//
+// Items that compare as equal are yielded in an unspecified order.
+//
+// The heap must not be modified during iteration.
+// This method is not concurrency safe. For concurrent applications, consider using a mutex, or pull the data out using Items().
+func (heap *MinBinaryHeap[T]) IteratorSorted() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if len(heap.heapData) == 0 {
+			return
+		}
+
+		frontier := &indexHeap[T]{
+			indices: []int{0},
+			heap:    heap,
+		}
+		for len(frontier.indices) > 0 {
+			index := frontier.pop()
+			if !yield(heap.heapData[index]) {
+				return
+			}
+
+			// The children of the visited item are now candidates for the next smallest item
+			leftIndex := 2*index + 1
+			rightIndex := 2*index + 2
+			if leftIndex < len(heap.heapData) {
+				frontier.push(leftIndex)
+			}
+			if rightIndex < len(heap.heapData) {
+				frontier.push(rightIndex)
+			}
+		}
+	}
+}
+
+// Get the k smallest items of the heap, in sorted order (smallest item first).
+// The heap is not modified.
+//
+// If k is larger than the size of the heap, all items of the heap are returned.
+// If k is not positive, an empty slice is returned.
+func (heap *MinBinaryHeap[T]) TopK(k int) []T {
+	k = max(0, min(k, len(heap.heapData)))
+	items := make([]T, 0, k)
+	if k == 0 {
+		return items
+	}
+
+	for item := range heap.IteratorSorted() {
+		items = append(items, item)
+		if len(items) == k {
+			break
+		}
+	}
+	return items
+}
+
+// Get the n smallest items from a slice, in sorted order (smallest item first), as determined by the comparatorFunction.
+// The given slice is not modified.
+//
+// Building the heap takes O(len(items)) time, after which the n smallest items are found in O(n log len(items)) time.
+//
+// If n is larger than the number of items, all items are returned in sorted order.
+// If n is not positive, an empty slice is returned.
+func NSmallest[T any](items []T, n int, comparatorFunction comparator.ComparatorFunction[T]) []T {
+	heap := New(comparatorFunction)
+	heap.heapData = make([]T, len(items))
+	copy(heap.heapData, items)
+	for index := len(heap.heapData)/2 - 1; index >= 0; index -= 1 {
+		heap.minHeapify(index)
+	}
+
+	return heap.TopK(n)
+}
+
+// Get the n largest items from a slice, in sorted order (largest item first), as determined by the comparatorFunction.
+// The given slice is not modified.
+//
+// This is equivalent to NSmallest with the comparatorFunction reversed.
+//
+// If n is larger than the number of items, all items are returned in sorted order.
+// If n is not positive, an empty slice is returned.
+func NLargest[T any](items []T, n int, comparatorFunction comparator.ComparatorFunction[T]) []T {
+	return NSmallest(items, n, func(a, b T) int {
+		return comparatorFunction(b, a)
+	})
+}
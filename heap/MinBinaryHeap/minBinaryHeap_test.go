@@ -114,6 +114,31 @@ func TestMinHeapAddRandomOrder(t *testing.T) {
 	}
 }
 
+func TestMinHeapNewFromSlice(t *testing.T) {
+	numItems := 100
+	items := make([]int, numItems)
+	for i := range numItems {
+		items[i] = i
+	}
+	rand.Shuffle(numItems, func(i, j int) {
+		items[i], items[j] = items[j], items[i]
+	})
+	originalItems := slices.Clone(items)
+
+	heap := minbinaryheap.NewFromSlice(items, comparator.DefaultIntegerComparator)
+	if !slices.Equal(items, originalItems) {
+		t.Errorf("creating heap from slice modified the slice")
+	}
+
+	expectedItems := slices.Sorted(slices.Values(items))
+	for _, expectedItem := range expectedItems {
+		item, err := heap.RemoveMin()
+		if err != nil || item != expectedItem {
+			t.Errorf("found removed item (%v, %v) does not match expected item (%v, %v)", item, err, expectedItem, nil)
+		}
+	}
+}
+
 // ----------------------------------------------------------------------------
 // Remove Tests
 
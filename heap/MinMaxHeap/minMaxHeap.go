@@ -0,0 +1,406 @@
+package minmaxheap
+
+import (
+	"iter"
+	"math/bits"
+
+	comparator "github.com/hmcalister/Go-DSA/utils/Comparator"
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
+)
+
+// Implement a min-max heap, also known as a double ended priority queue.
+//
+// A min-max heap allows for both the minimal and maximal items to be peeked in constant time,
+// and removed in logarithmic time, from the same collection.
+type MinMaxHeap[T any] struct {
+	heapData           []T
+	comparatorFunction comparator.ComparatorFunction[T]
+}
+
+// Create a new MinMaxHeap, with comparator given by the comparatorFunction.
+//
+// See `github.com/hmcalister/Go-DSA/Comparator` for more information on the comparator.
+func New[T any](comparatorFunction comparator.ComparatorFunction[T]) *MinMaxHeap[T] {
+	return &MinMaxHeap[T]{
+		// Store the heap as an array, exactly like a binary heap.
+		// The root is stored in heapData[0], then recursively the
+		// node at index `i` has left child at `2i+1` and right child at `2i+2`.
+		// Therefore, the parent of a node is given by floor( (i-1) / 2 ).
+		//
+		// Unlike a binary heap, the levels of the tree alternate between "min levels" and "max levels".
+		// The root is on a min level, its children on a max level, its grandchildren on a min level, and so on.
+		// Each node on a min level is smaller than all of its descendants,
+		// and each node on a max level is larger than all of its descendants.
+		heapData:           make([]T, 0),
+		comparatorFunction: comparatorFunction,
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Heap Helper Methods
+
+// Determine if the node at the given index is on a min level of the heap.
+func isMinLevel(index int) bool {
+	// The level of a node is floor(log2(index+1)), and min levels are the even levels
+	return (bits.Len(uint(index+1))-1)%2 == 0
+}
+
+// Compare the items at two indices, respecting the ordering of the given level.
+//
+// On a min level, returns true if the item at a is smaller than the item at b.
+// On a max level, returns true if the item at a is larger than the item at b.
+func (heap *MinMaxHeap[T]) ordered(a, b int, minLevel bool) bool {
+	comparison := heap.comparatorFunction(heap.heapData[a], heap.heapData[b])
+	if minLevel {
+		return comparison < 0
+	}
+	return comparison > 0
+}
+
+// Swap the items at two indices.
+func (heap *MinMaxHeap[T]) swap(a, b int) {
+	heap.heapData[a], heap.heapData[b] = heap.heapData[b], heap.heapData[a]
+}
+
+// Move the item at targetIndex up the heap until the heap property is restored.
+//
+// This is used after adding a new item at the bottom of the heap.
+func (heap *MinMaxHeap[T]) pushUp(targetIndex int) {
+	if targetIndex == 0 {
+		return
+	}
+
+	// First, check the item against its parent, which is on the opposite kind of level.
+	// If the item belongs on the opposite kind of level, swap it with the parent
+	// and continue to push up along that kind of level.
+	minLevel := isMinLevel(targetIndex)
+	parentIndex := (targetIndex - 1) / 2
+	if heap.ordered(parentIndex, targetIndex, minLevel) {
+		heap.swap(parentIndex, targetIndex)
+		heap.pushUpGrandparent(parentIndex, !minLevel)
+	} else {
+		heap.pushUpGrandparent(targetIndex, minLevel)
+	}
+}
+
+// Move the item at targetIndex up the heap by grandparents (staying on the same kind of level)
+// until the heap property is restored.
+func (heap *MinMaxHeap[T]) pushUpGrandparent(targetIndex int, minLevel bool) {
+	for targetIndex > 2 {
+		grandparentIndex := ((targetIndex-1)/2 - 1) / 2
+		if !heap.ordered(targetIndex, grandparentIndex, minLevel) {
+			return
+		}
+		heap.swap(targetIndex, grandparentIndex)
+		targetIndex = grandparentIndex
+	}
+}
+
+// Move the item at targetIndex down the heap until the heap property is restored.
+//
+// This is the min-max heap analogue of heapify.
+func (heap *MinMaxHeap[T]) pushDown(targetIndex int) {
+	minLevel := isMinLevel(targetIndex)
+
+	for {
+		// Find the smallest (largest, on a max level) of the children and grandchildren
+		firstChildIndex := 2*targetIndex + 1
+		if firstChildIndex >= len(heap.heapData) {
+			return
+		}
+
+		bestIndex := firstChildIndex
+		candidateIndices := [5]int{
+			firstChildIndex + 1,
+			4*targetIndex + 3,
+			4*targetIndex + 4,
+			4*targetIndex + 5,
+			4*targetIndex + 6,
+		}
+		for _, candidateIndex := range candidateIndices {
+			if candidateIndex < len(heap.heapData) && heap.ordered(candidateIndex, bestIndex, minLevel) {
+				bestIndex = candidateIndex
+			}
+		}
+
+		// If the best descendant is a child, we may need to swap once but are then done,
+		// as the child has no children of its own that could be disturbed
+		// (otherwise, a grandchild would have been a better candidate or equal).
+		if bestIndex <= firstChildIndex+1 {
+			if heap.ordered(bestIndex, targetIndex, minLevel) {
+				heap.swap(bestIndex, targetIndex)
+			}
+			return
+		}
+
+		// Otherwise the best descendant is a grandchild.
+		if !heap.ordered(bestIndex, targetIndex, minLevel) {
+			return
+		}
+		heap.swap(bestIndex, targetIndex)
+
+		// The item moved to the grandchild may now be out of order with its parent,
+		// which is on the opposite kind of level.
+		parentIndex := (bestIndex - 1) / 2
+		if heap.ordered(parentIndex, bestIndex, minLevel) {
+			heap.swap(parentIndex, bestIndex)
+		}
+		targetIndex = bestIndex
+	}
+}
+
+// Restore the heap property across the entire heap.
+func (heap *MinMaxHeap[T]) heapifyAll() {
+	for index := len(heap.heapData)/2 - 1; index >= 0; index -= 1 {
+		heap.pushDown(index)
+	}
+}
+
+// Get the index of the maximal item of the heap.
+//
+// Must not be called on an empty heap.
+func (heap *MinMaxHeap[T]) maxIndex() int {
+	// The maximal item is one of the children of the root, or the root itself if there are no children.
+	switch len(heap.heapData) {
+	case 1:
+		return 0
+	case 2:
+		return 1
+	}
+	if heap.comparatorFunction(heap.heapData[2], heap.heapData[1]) > 0 {
+		return 2
+	}
+	return 1
+}
+
+// Remove the item at the given index, returning that item.
+//
+// Must not be called with an index out of range.
+func (heap *MinMaxHeap[T]) removeAtIndex(targetIndex int) T {
+	targetItem := heap.heapData[targetIndex]
+
+	// Replace the target with the final element and slice off one element
+	heapSize := len(heap.heapData) - 1
+	heap.heapData[targetIndex] = heap.heapData[heapSize]
+	heap.heapData[heapSize] = *new(T)
+	heap.heapData = heap.heapData[:heapSize]
+
+	if targetIndex < heapSize {
+		heap.pushDown(targetIndex)
+	}
+
+	return targetItem
+}
+
+// ----------------------------------------------------------------------------
+// Get methods
+
+// Peek at the min-element of this heap. The item is not removed from the heap.
+//
+// If the heap is empty, a dsa_error.ErrorDataStructureEmpty is returned.
+func (heap *MinMaxHeap[T]) PeekMin() (T, error) {
+	if len(heap.heapData) == 0 {
+		return *new(T), dsa_error.ErrorDataStructureEmpty
+	}
+
+	return heap.heapData[0], nil
+}
+
+// Peek at the max-element of this heap. The item is not removed from the heap.
+//
+// If the heap is empty, a dsa_error.ErrorDataStructureEmpty is returned.
+func (heap *MinMaxHeap[T]) PeekMax() (T, error) {
+	if len(heap.heapData) == 0 {
+		return *new(T), dsa_error.ErrorDataStructureEmpty
+	}
+
+	return heap.heapData[heap.maxIndex()], nil
+}
+
+// Find the first item in a heap matching a predicate.
+//
+// Returns (item, nil) if the item is present, or (*new(T), dsa_error.ErrorItemNotFound) if the item is not present.
+func (heap *MinMaxHeap[T]) Find(predicate func(item T) bool) (T, error) {
+	for _, item := range heap.heapData {
+		if predicate(item) {
+			return item, nil
+		}
+	}
+	return *new(T), dsa_error.ErrorItemNotFound
+}
+
+// Find all items in a heap matching a predicate.
+//
+// Returns all items from the heap that match the predicate.
+func (heap *MinMaxHeap[T]) FindAll(predicate func(item T) bool) []T {
+	foundItems := make([]T, 0)
+	for _, item := range heap.heapData {
+		if predicate(item) {
+			foundItems = append(foundItems, item)
+		}
+	}
+	return foundItems
+}
+
+// Get all items from the heap. This method allocates an array of length equal to the number of items.
+func (heap *MinMaxHeap[T]) Items() []T {
+	items := make([]T, heap.Size())
+	copy(items, heap.heapData)
+	return items
+}
+
+// Get the size of this heap.
+func (heap *MinMaxHeap[T]) Size() int {
+	return len(heap.heapData)
+}
+
+// ----------------------------------------------------------------------------
+// Add methods
+
+// Add a new element to the heap.
+//
+// Heaps are allowed to have duplicate values.
+func (heap *MinMaxHeap[T]) Add(item T) {
+	// Add the new item to the end of the heap, then push it up to the correct level
+	heap.heapData = append(heap.heapData, item)
+	heap.pushUp(len(heap.heapData) - 1)
+}
+
+// ----------------------------------------------------------------------------
+// Remove methods
+
+// Remove (and return) the minimal item from this heap.
+//
+// If the heap is empty, a dsa_error.ErrorDataStructureEmpty is returned.
+func (heap *MinMaxHeap[T]) RemoveMin() (T, error) {
+	if len(heap.heapData) == 0 {
+		return *new(T), dsa_error.ErrorDataStructureEmpty
+	}
+
+	return heap.removeAtIndex(0), nil
+}
+
+// Remove (and return) the maximal item from this heap.
+//
+// If the heap is empty, a dsa_error.ErrorDataStructureEmpty is returned.
+func (heap *MinMaxHeap[T]) RemoveMax() (T, error) {
+	if len(heap.heapData) == 0 {
+		return *new(T), dsa_error.ErrorDataStructureEmpty
+	}
+
+	return heap.removeAtIndex(heap.maxIndex()), nil
+}
+
+// Remove (and return) an item from the heap.
+// If the heap is empty, a dsa_error.ErrorDataStructureEmpty is returned.
+// If the item is not present in the heap, a dsa_error.ErrorItemNotFound is returned.
+//
+// Finding the item requires a linear scan of the heap, and hence this method is O(n).
+func (heap *MinMaxHeap[T]) RemoveItem(item T) (T, error) {
+	if len(heap.heapData) == 0 {
+		return *new(T), dsa_error.ErrorDataStructureEmpty
+	}
+
+	targetItemIndex := -1
+	for i, currItem := range heap.heapData {
+		if heap.comparatorFunction(currItem, item) == 0 {
+			targetItemIndex = i
+			break
+		}
+	}
+	// If we did not set the index, we did not find the item
+	if targetItemIndex == -1 {
+		return *new(T), dsa_error.ErrorItemNotFound
+	}
+
+	// Unlike a binary heap, the replacement item may be out of order with both its ancestors
+	// *and* its descendants, on either kind of level. Since finding the item is already linear,
+	// we simply restore the heap property across the entire heap (also linear).
+	targetItem := heap.heapData[targetItemIndex]
+	heapSize := len(heap.heapData) - 1
+	heap.heapData[targetItemIndex] = heap.heapData[heapSize]
+	heap.heapData[heapSize] = *new(T)
+	heap.heapData = heap.heapData[:heapSize]
+	heap.heapifyAll()
+
+	return targetItem, nil
+}
+
+// ----------------------------------------------------------------------------
+// Apply, Map, and Fold methods
+//
+// Methods to apply a function across ALL items in a heap.
+
+// Iterate over the heap and apply a function to each item.
+// In case it matters, the iteration is effectively in "reading order" along the heap.
+// Since Apply does not update the heap items, this method does *not* restore heap order.
+//
+// Idiomatic Go should likely use Iterator() rather than functional methods.
+//
+// It is expected that Apply does *not* update the heap items.
+// To modify the heap items, use Map.
+// To accumulate values over the heap, use Fold.
+func Apply[T any](heap *MinMaxHeap[T], f func(item T)) {
+	for index := 0; index < len(heap.heapData); index += 1 {
+		f(heap.heapData[index])
+	}
+}
+
+// Iterate over the heap and apply a function to each item, assigning the result to the item.
+// In case it matters, the iteration is effectively in "reading order" along the heap.
+// The result of this function is then assigned to the node at each step.
+//
+// Idiomatic Go should likely use Iterator() rather than functional methods.
+//
+// BEWARE: Since this method updates the heap data, this method restores heap order afterwards.
+// However, since this method may update *all* heap items, this method pushes down *all* non-leaf items.
+// That is potentially very expensive!
+//
+// Map can update the node items by returning the update value.
+// If you do not need to modify the heap items, use Apply.
+// To accumulate values over the heap, use Fold.
+func Map[T any](heap *MinMaxHeap[T], f func(item T) T) {
+	for index := 0; index < len(heap.heapData); index += 1 {
+		heap.heapData[index] = f(heap.heapData[index])
+	}
+
+	heap.heapifyAll()
+}
+
+// Iterate over the heap and apply the function f to it.
+// In case it matters, the iteration is effectively in "reading order" along the heap.
+// The function f also takes the current value of the accumulator.
+// The results of f become the new value of the accumulator at each step.
+//
+// This function returns the final accumulator.
+//
+// Idiomatic Go should likely use Iterator() rather than functional methods.
+//
+// This function is not a method on MinMaxHeap to allow for generic accumulators.
+func Fold[T any, G any](heap *MinMaxHeap[T], initialAccumulator G, f func(item T, accumulator G) G) G {
+	accumulator := initialAccumulator
+	for index := 0; index < len(heap.heapData); index += 1 {
+		accumulator = f(heap.heapData[index], accumulator)
+	}
+
+	return accumulator
+}
+
+// Iterate over the items of the heap.
+// In case it matters, the iteration is effectively in "reading order" along the heap.
+// This is *not* a sorted order. To iterate in sorted order you may either extract the heap items with Items() and sort,
+// or continually pop items from either end of the heap (which will naturally update the heap).
+//
+// If you are updating items in the heap, please note this method does *not* restore heap order.
+//
+// This method is not concurrency safe. For concurrent applications, consider using a mutex, or pull the data out using Items().
+func (heap *MinMaxHeap[T]) Iterator() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for index := 0; index < len(heap.heapData); index += 1 {
+			item := heap.heapData[index]
+			if !yield(item) {
+				break
+			}
+		}
+	}
+}
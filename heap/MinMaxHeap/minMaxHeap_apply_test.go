@@ -0,0 +1,94 @@
+package minmaxheap_test
+
+import (
+	"slices"
+	"testing"
+
+	minmaxheap "github.com/hmcalister/Go-DSA/heap/MinMaxHeap"
+	comparator "github.com/hmcalister/Go-DSA/utils/Comparator"
+)
+
+func TestMinMaxHeapApply(t *testing.T) {
+	heap := minmaxheap.New[int](comparator.DefaultIntegerComparator)
+	items := []int{1, 2, 3, 4, 5, 6, 7, 8, 9}
+	for _, item := range items {
+		heap.Add(item)
+	}
+
+	sum := 0
+	minmaxheap.Apply(heap, func(item int) { sum += item })
+	expectedSum := 0
+	for _, item := range items {
+		expectedSum += item
+	}
+
+	if sum != expectedSum {
+		t.Errorf("result (%v) does not match expected result (%v)", sum, expectedSum)
+	}
+}
+
+func TestMinMaxHeapMapUpdateHeap(t *testing.T) {
+	heap := minmaxheap.New[int](comparator.DefaultIntegerComparator)
+	items := []int{9, 8, 7, 6, 5, 4, 3, 2, 1}
+	for _, item := range items {
+		heap.Add(item)
+	}
+
+	minmaxheap.Map(heap, func(item int) int {
+		newItem := 9 - item
+		return newItem
+	})
+
+	expectedMinItem := 0
+	minItem, _ := heap.PeekMin()
+	if minItem != expectedMinItem {
+		t.Errorf("expected minimum item %v does not match found minimum item %v", expectedMinItem, minItem)
+	}
+
+	expectedMaxItem := 8
+	maxItem, _ := heap.PeekMax()
+	if maxItem != expectedMaxItem {
+		t.Errorf("expected maximum item %v does not match found maximum item %v", expectedMaxItem, maxItem)
+	}
+}
+
+func TestMinMaxHeapFold(t *testing.T) {
+	heap := minmaxheap.New[string](comparator.DefaultStringComparator)
+	items := []string{"a", "b", "c", "d", "e", "f", "g", "h"}
+	for _, item := range items {
+		heap.Add(item)
+	}
+
+	itemsContained := minmaxheap.Fold(heap, true, func(item string, accumulator bool) bool {
+		if !accumulator {
+			return accumulator
+		}
+		return slices.Contains(items, item)
+	})
+
+	if !itemsContained {
+		t.Errorf("expected all items to be contained in ground truth array")
+	}
+}
+
+func TestMinMaxHeapIterator(t *testing.T) {
+	heap := minmaxheap.New[int](comparator.DefaultIntegerComparator)
+	items := []int{1, 2, 3, 4, 5, 6, 7, 8, 9}
+	for _, item := range items {
+		heap.Add(item)
+	}
+
+	sum := 0
+	for item := range heap.Iterator() {
+		sum += item
+	}
+
+	expectedSum := 0
+	for _, item := range items {
+		expectedSum += item
+	}
+
+	if sum != expectedSum {
+		t.Errorf("result (%v) does not match expected result (%v)", sum, expectedSum)
+	}
+}
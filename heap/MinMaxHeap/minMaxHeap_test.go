@@ -0,0 +1,283 @@
+package minmaxheap_test
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	minmaxheap "github.com/hmcalister/Go-DSA/heap/MinMaxHeap"
+	comparator "github.com/hmcalister/Go-DSA/utils/Comparator"
+)
+
+// ----------------------------------------------------------------------------
+// Initialization Tests
+
+func TestMinMaxHeapIntInit(t *testing.T) {
+	minmaxheap.New[int](comparator.DefaultIntegerComparator)
+}
+
+func TestMinMaxHeapFloatInit(t *testing.T) {
+	minmaxheap.New[float64](comparator.DefaultFloat64Comparator)
+}
+
+func TestMinMaxHeapStringInit(t *testing.T) {
+	minmaxheap.New[string](comparator.DefaultStringComparator)
+}
+
+func TestMinMaxHeapStructInit(t *testing.T) {
+	type S struct {
+		_ int
+		f float64
+	}
+	minmaxheap.New[S](func(a, b S) int {
+		if a.f > b.f {
+			return 1
+		} else if a.f < b.f {
+			return -1
+		} else {
+			return 0
+		}
+	})
+}
+
+// ----------------------------------------------------------------------------
+// Misc Tests
+
+func TestMinMaxHeapRemoveFromEmpty(t *testing.T) {
+	heap := minmaxheap.New[int](comparator.DefaultIntegerComparator)
+
+	_, err := heap.RemoveMin()
+	if err == nil {
+		t.Errorf("got nil error when removing min from empty heap")
+	}
+
+	_, err = heap.RemoveMax()
+	if err == nil {
+		t.Errorf("got nil error when removing max from empty heap")
+	}
+}
+
+func TestMinMaxHeapPeekEmpty(t *testing.T) {
+	heap := minmaxheap.New[int](comparator.DefaultIntegerComparator)
+
+	_, err := heap.PeekMin()
+	if err == nil {
+		t.Errorf("got nil error when peeking min of empty heap")
+	}
+
+	_, err = heap.PeekMax()
+	if err == nil {
+		t.Errorf("got nil error when peeking max of empty heap")
+	}
+}
+
+func TestMinMaxHeapSize(t *testing.T) {
+	heap := minmaxheap.New[int](comparator.DefaultIntegerComparator)
+
+	items := []int{5, 3, 9, 1, 7}
+	for i, item := range items {
+		heap.Add(item)
+		if heap.Size() != i+1 {
+			t.Errorf("heap size (%v) does not match expected size (%v)", heap.Size(), i+1)
+		}
+	}
+}
+
+func TestMinMaxHeapItems(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5, 6, 7, 8, 9}
+	heap := minmaxheap.New[int](comparator.DefaultIntegerComparator)
+
+	for _, item := range items {
+		heap.Add(item)
+	}
+
+	retrievedItems := heap.Items()
+	for _, item := range items {
+		if !slices.Contains(retrievedItems, item) {
+			t.Errorf("retrieved items %v does not contain expected item %v", retrievedItems, item)
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Peek Tests
+
+func TestMinMaxHeapAddPeek(t *testing.T) {
+	heap := minmaxheap.New[int](comparator.DefaultIntegerComparator)
+
+	numItems := 100
+	items := make([]int, numItems)
+	for i := range numItems {
+		items[i] = i
+	}
+	rand.Shuffle(numItems, func(i, j int) {
+		items[i], items[j] = items[j], items[i]
+	})
+
+	expectedMinItem := items[0]
+	expectedMaxItem := items[0]
+	for _, item := range items {
+		heap.Add(item)
+		expectedMinItem = min(expectedMinItem, item)
+		expectedMaxItem = max(expectedMaxItem, item)
+
+		heapMinItem, err := heap.PeekMin()
+		if err != nil {
+			t.Errorf("found error when getting min item from a non-empty heap: %v", err)
+		}
+		if heapMinItem != expectedMinItem {
+			t.Errorf("heap min item (%v) does not match expected min item (%v)", heapMinItem, expectedMinItem)
+		}
+
+		heapMaxItem, err := heap.PeekMax()
+		if err != nil {
+			t.Errorf("found error when getting max item from a non-empty heap: %v", err)
+		}
+		if heapMaxItem != expectedMaxItem {
+			t.Errorf("heap max item (%v) does not match expected max item (%v)", heapMaxItem, expectedMaxItem)
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Remove Tests
+
+func TestMinMaxHeapRemoveMinItem(t *testing.T) {
+	items := []int{9, 8, 7, 6, 5, 4, 3, 2, 1}
+	heap := minmaxheap.New[int](comparator.DefaultIntegerComparator)
+
+	for _, item := range items {
+		heap.Add(item)
+	}
+
+	slices.Reverse(items)
+	for _, expectedItem := range items {
+		removedItem, err := heap.RemoveMin()
+		if err != nil {
+			t.Errorf("failed to remove min item from a heap of size %v", heap.Size())
+		}
+		if removedItem != expectedItem {
+			t.Errorf("removed min item (%v) does not match expected min item (%v)", removedItem, expectedItem)
+		}
+	}
+}
+
+func TestMinMaxHeapRemoveMaxItem(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5, 6, 7, 8, 9}
+	heap := minmaxheap.New[int](comparator.DefaultIntegerComparator)
+
+	for _, item := range items {
+		heap.Add(item)
+	}
+
+	slices.Reverse(items)
+	for _, expectedItem := range items {
+		removedItem, err := heap.RemoveMax()
+		if err != nil {
+			t.Errorf("failed to remove max item from a heap of size %v", heap.Size())
+		}
+		if removedItem != expectedItem {
+			t.Errorf("removed max item (%v) does not match expected max item (%v)", removedItem, expectedItem)
+		}
+	}
+}
+
+func TestMinMaxHeapRemoveItem(t *testing.T) {
+	items := []int{9, 8, 7, 6, 5, 4, 3, 2, 1}
+	heap := minmaxheap.New[int](comparator.DefaultIntegerComparator)
+
+	for _, item := range items {
+		heap.Add(item)
+	}
+
+	targetItem := 5
+	removedItem, err := heap.RemoveItem(targetItem)
+	if err != nil {
+		t.Errorf("failed to remove item from a heap of size %v", heap.Size())
+	}
+	if removedItem != targetItem {
+		t.Errorf("removed item (%v) does not match expected item (%v)", removedItem, targetItem)
+	}
+
+	_, err = heap.RemoveItem(targetItem)
+	if err == nil {
+		t.Errorf("got nil error when removing item not present in heap")
+	}
+}
+
+func TestMinMaxHeapRandomRemoveBothEnds(t *testing.T) {
+	heap := minmaxheap.New[int](comparator.DefaultIntegerComparator)
+
+	numItems := 1000
+	items := make([]int, numItems)
+	for i := range numItems {
+		items[i] = rand.Intn(numItems / 4)
+	}
+	for _, item := range items {
+		heap.Add(item)
+	}
+
+	sortedItems := slices.Clone(items)
+	slices.Sort(sortedItems)
+	for len(sortedItems) > 0 {
+		if rand.Intn(2) == 0 {
+			removedItem, err := heap.RemoveMin()
+			if err != nil {
+				t.Fatalf("failed to remove min item from a heap of size %v", heap.Size())
+			}
+			if removedItem != sortedItems[0] {
+				t.Fatalf("removed min item (%v) does not match expected min item (%v)", removedItem, sortedItems[0])
+			}
+			sortedItems = sortedItems[1:]
+		} else {
+			removedItem, err := heap.RemoveMax()
+			if err != nil {
+				t.Fatalf("failed to remove max item from a heap of size %v", heap.Size())
+			}
+			if removedItem != sortedItems[len(sortedItems)-1] {
+				t.Fatalf("removed max item (%v) does not match expected max item (%v)", removedItem, sortedItems[len(sortedItems)-1])
+			}
+			sortedItems = sortedItems[:len(sortedItems)-1]
+		}
+	}
+
+	if heap.Size() != 0 {
+		t.Errorf("heap size (%v) does not match expected size (%v)", heap.Size(), 0)
+	}
+}
+
+func TestMinMaxHeapRandomRemoveItem(t *testing.T) {
+	heap := minmaxheap.New[int](comparator.DefaultIntegerComparator)
+
+	numItems := 200
+	items := make([]int, numItems)
+	for i := range numItems {
+		items[i] = i
+	}
+	rand.Shuffle(numItems, func(i, j int) {
+		items[i], items[j] = items[j], items[i]
+	})
+	for _, item := range items {
+		heap.Add(item)
+	}
+
+	// Remove every second item in a random order, then check the remaining items drain in order
+	remainingItems := make([]int, 0)
+	for _, item := range items {
+		if item%2 == 0 {
+			if _, err := heap.RemoveItem(item); err != nil {
+				t.Fatalf("failed to remove item %v from heap: %v", item, err)
+			}
+		} else {
+			remainingItems = append(remainingItems, item)
+		}
+	}
+
+	slices.Sort(remainingItems)
+	for _, expectedItem := range remainingItems {
+		removedItem, _ := heap.RemoveMin()
+		if removedItem != expectedItem {
+			t.Fatalf("removed min item (%v) does not match expected min item (%v)", removedItem, expectedItem)
+		}
+	}
+}
@@ -0,0 +1,205 @@
+package linkedlist
+
+// A Cursor is a position in a LinkedList that can move in both directions and edit the list in place.
+//
+// A cursor is either on a node of the list, or at the "ghost" position that sits between the back and the front of the list.
+// Moving forward from the back, or backward from the front, reaches the ghost position.
+// Moving forward from the ghost position reaches the front, and moving backward reaches the back.
+// A cursor over an empty list is always at the ghost position.
+//
+// Since a cursor refers to a node rather than an index, it remains valid while the list is modified elsewhere,
+// including by other cursors, Add, AddAtIndex, or the node handle methods.
+// If the node under the cursor is removed from the list by any other means (or moved to another list, e.g. by SplitAt),
+// the cursor is invalidated: Valid() returns false and all other methods return ErrorCursorInvalidated.
+//
+// Example:
+//
+// ```
+//
+// // Remove all negative items, and double the rest
+//
+//	for cursor := list.CursorFront(); cursor.Valid(); {
+//		item, _ := cursor.Item()
+//		if item < 0 {
+//			cursor.Remove()
+//		} else {
+//			cursor.Set(2 * item)
+//			cursor.Next()
+//		}
+//	}
+//
+// ```
+type Cursor[T any] struct {
+	list *LinkedList[T]
+
+	// The node under the cursor, or nil if the cursor is at the ghost position.
+	node *LinkedListNode[T]
+}
+
+// Create a cursor on the front of the list. If the list is empty, the cursor is at the ghost position.
+func (list *LinkedList[T]) CursorFront() *Cursor[T] {
+	return &Cursor[T]{
+		list: list,
+		node: list.head,
+	}
+}
+
+// Create a cursor on the back of the list. If the list is empty, the cursor is at the ghost position.
+func (list *LinkedList[T]) CursorBack() *Cursor[T] {
+	return &Cursor[T]{
+		list: list,
+		node: list.tail,
+	}
+}
+
+// Determine if the cursor's node has been removed from the list by something other than this cursor.
+func (cursor *Cursor[T]) isInvalidated() bool {
+	return cursor.node != nil && !cursor.list.owns(cursor.node)
+}
+
+// ----------------------------------------------------------------------------
+// Get Methods
+
+// Determine if the cursor is on an item of the list, i.e. it is not at the ghost position and has not been invalidated.
+func (cursor *Cursor[T]) Valid() bool {
+	return cursor.node != nil && !cursor.isInvalidated()
+}
+
+// Get the item under the cursor.
+//
+// Returns an ErrorCursorAtGhost if the cursor is at the ghost position,
+// or an ErrorCursorInvalidated if the node under the cursor has been removed from the list.
+func (cursor *Cursor[T]) Item() (T, error) {
+	if cursor.node == nil {
+		return *new(T), ErrorCursorAtGhost
+	}
+	if cursor.isInvalidated() {
+		return *new(T), ErrorCursorInvalidated
+	}
+
+	return cursor.node.item, nil
+}
+
+// Get the node under the cursor, for use with the node handle methods of the list.
+//
+// Returns nil if the cursor is at the ghost position or has been invalidated.
+func (cursor *Cursor[T]) Node() *LinkedListNode[T] {
+	if !cursor.Valid() {
+		return nil
+	}
+
+	return cursor.node
+}
+
+// ----------------------------------------------------------------------------
+// Move Methods
+
+// Move the cursor forward, towards the back of the list.
+// From the back of the list the cursor moves to the ghost position, and from the ghost position to the front.
+//
+// Returns an ErrorCursorInvalidated if the node under the cursor has been removed from the list, in which case the cursor does not move.
+func (cursor *Cursor[T]) Next() error {
+	if cursor.isInvalidated() {
+		return ErrorCursorInvalidated
+	}
+
+	if cursor.node == nil {
+		cursor.node = cursor.list.head
+	} else {
+		cursor.node = cursor.node.next
+	}
+	return nil
+}
+
+// Move the cursor backward, towards the front of the list.
+// From the front of the list the cursor moves to the ghost position, and from the ghost position to the back.
+//
+// Returns an ErrorCursorInvalidated if the node under the cursor has been removed from the list, in which case the cursor does not move.
+func (cursor *Cursor[T]) Previous() error {
+	if cursor.isInvalidated() {
+		return ErrorCursorInvalidated
+	}
+
+	if cursor.node == nil {
+		cursor.node = cursor.list.tail
+	} else {
+		cursor.node = cursor.node.prev
+	}
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Edit Methods
+
+// Replace the item under the cursor.
+//
+// Returns an ErrorCursorAtGhost if the cursor is at the ghost position,
+// or an ErrorCursorInvalidated if the node under the cursor has been removed from the list.
+func (cursor *Cursor[T]) Set(item T) error {
+	if cursor.node == nil {
+		return ErrorCursorAtGhost
+	}
+	if cursor.isInvalidated() {
+		return ErrorCursorInvalidated
+	}
+
+	cursor.node.item = item
+	return nil
+}
+
+// Insert a new item immediately before the cursor. The cursor does not move.
+// If the cursor is at the ghost position, the item is added to the back of the list.
+//
+// Returns an ErrorCursorInvalidated if the node under the cursor has been removed from the list.
+func (cursor *Cursor[T]) InsertBefore(item T) error {
+	if cursor.isInvalidated() {
+		return ErrorCursorInvalidated
+	}
+
+	newNode := &LinkedListNode[T]{
+		item: item,
+	}
+	if cursor.node == nil {
+		cursor.list.linkAfter(newNode, cursor.list.tail)
+	} else {
+		cursor.list.linkAfter(newNode, cursor.node.prev)
+	}
+	return nil
+}
+
+// Insert a new item immediately after the cursor. The cursor does not move.
+// If the cursor is at the ghost position, the item is added to the front of the list.
+//
+// Returns an ErrorCursorInvalidated if the node under the cursor has been removed from the list.
+func (cursor *Cursor[T]) InsertAfter(item T) error {
+	if cursor.isInvalidated() {
+		return ErrorCursorInvalidated
+	}
+
+	newNode := &LinkedListNode[T]{
+		item: item,
+	}
+	// If the cursor is at the ghost position, cursor.node is nil and linkAfter inserts at the head
+	cursor.list.linkAfter(newNode, cursor.node)
+	return nil
+}
+
+// Remove and return the item under the cursor. The cursor moves forward to the next item (or the ghost position).
+//
+// Any other cursor on the removed item is invalidated.
+//
+// Returns an ErrorCursorAtGhost if the cursor is at the ghost position,
+// or an ErrorCursorInvalidated if the node under the cursor has been removed from the list.
+func (cursor *Cursor[T]) Remove() (T, error) {
+	if cursor.node == nil {
+		return *new(T), ErrorCursorAtGhost
+	}
+	if cursor.isInvalidated() {
+		return *new(T), ErrorCursorInvalidated
+	}
+
+	removedNode := cursor.node
+	cursor.node = removedNode.next
+	cursor.list.unlink(removedNode)
+	return removedNode.item, nil
+}
@@ -0,0 +1,10 @@
+package linkedlist
+
+import "errors"
+
+var (
+	ErrorNodeNotInList     = errors.New("node does not belong to this list")
+	ErrorSelfSplice        = errors.New("cannot splice a list into itself")
+	ErrorCursorAtGhost     = errors.New("cursor is not on an item")
+	ErrorCursorInvalidated = errors.New("item under cursor has been removed from the list")
+)
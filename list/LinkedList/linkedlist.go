@@ -3,6 +3,7 @@ package linkedlist
 import (
 	"iter"
 
+	comparator "github.com/hmcalister/Go-DSA/utils/Comparator"
 	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
 )
 
@@ -20,15 +21,20 @@ type LinkedList[T any] struct {
 
 	// Length of the list, the total number of Nodes.
 	length int
+
+	// The owner of the nodes of this list. See nodeOwner.
+	owner *nodeOwner[T]
 }
 
 // Create a new linked list.
 func New[T any]() *LinkedList[T] {
-	return &LinkedList[T]{
+	list := &LinkedList[T]{
 		head:   nil,
 		tail:   nil,
 		length: 0,
 	}
+	list.owner = &nodeOwner[T]{list: list}
+	return list
 }
 
 // Get the length of this linked list.
@@ -107,25 +113,25 @@ func (list *LinkedList[T]) ReverseFindAll(predicate func(item T) bool) []T {
 //
 // Returns a dsa_error.ErrorIndexOutOfBounds if the index is out of bounds.
 func (list *LinkedList[T]) ItemAtIndex(index int) (T, error) {
-	if list.length <= index {
+	if index < 0 || list.length <= index {
 		return *new(T), dsa_error.ErrorIndexOutOfBounds
 	}
 
-	// If the target index is after the halfway point
-	// we can traverse backwards to find the node
-	if index > list.length/2 {
-		currentNode := list.tail
-		for range list.length - index - 1 {
-			currentNode = currentNode.prev
-		}
-		return currentNode.item, nil
-	} else {
-		currentNode := list.head
-		for range index {
-			currentNode = currentNode.next
-		}
-		return currentNode.item, nil
-	}
+	return list.nodeAtIndex(index).item, nil
+}
+
+// Get the first node of the list, or nil if the list is empty.
+//
+// The node can be used to walk the list with Next(), or as a position for InsertBefore, InsertAfter, RemoveNode, and the Move methods.
+func (list *LinkedList[T]) Front() *LinkedListNode[T] {
+	return list.head
+}
+
+// Get the last node of the list, or nil if the list is empty.
+//
+// The node can be used to walk the list with Previous(), or as a position for InsertBefore, InsertAfter, RemoveNode, and the Move methods.
+func (list *LinkedList[T]) Back() *LinkedListNode[T] {
+	return list.tail
 }
 
 // Get all items from the list. This method allocates an array of length equal to the number of items.
@@ -283,34 +289,85 @@ func (list *LinkedList[T]) ReverseIterator() iter.Seq2[int, T] {
 }
 
 // ----------------------------------------------------------------------------
-// Add methods
+// Node link methods
+//
+// Internal methods to link and unlink nodes, keeping head, tail, length, and node ownership consistent.
 
-// Add a new item to the end of the list.
-func (list *LinkedList[T]) Add(item T) {
-	newNode := &LinkedListNode[T]{
-		item: item,
+// Link a node into the list immediately after mark. If mark is nil, the node becomes the new head.
+//
+// The node must not currently be linked into any list, and mark must be nil or a node of this list.
+func (list *LinkedList[T]) linkAfter(node *LinkedListNode[T], mark *LinkedListNode[T]) {
+	node.owner = list.owner
+	node.prev = mark
+	if mark == nil {
+		node.next = list.head
+		list.head = node
+	} else {
+		node.next = mark.next
+		mark.next = node
 	}
 
-	// If length is zero, tail is nil as we have an empty list
-	if list.length == 0 {
-		list.head = newNode
-		list.tail = newNode
-		list.length += 1
-		return
+	if node.next == nil {
+		list.tail = node
+	} else {
+		node.next.prev = node
 	}
 
-	// Otherwise, we add the node to the end of the list
-	//
-	// This is defined as tail.next
-	// we also ensure the previous is set correctly
-	list.tail.next = newNode
-	newNode.prev = list.tail
+	list.length += 1
+}
+
+// Unlink a node of this list, clearing its pointers so it no longer belongs to any list.
+func (list *LinkedList[T]) unlink(node *LinkedListNode[T]) {
+	if node.prev == nil {
+		list.head = node.next
+	} else {
+		node.prev.next = node.next
+	}
 
-	// Update the tail to be the newly inserted node
-	list.tail = newNode
+	if node.next == nil {
+		list.tail = node.prev
+	} else {
+		node.next.prev = node.prev
+	}
 
-	// Finally, update the length
-	list.length += 1
+	node.next = nil
+	node.prev = nil
+	node.owner = nil
+	list.length -= 1
+}
+
+// Get the node at the specified index, walking from whichever end of the list is closer.
+//
+// The index must be in bounds, i.e. 0 <= index < list.length.
+func (list *LinkedList[T]) nodeAtIndex(index int) *LinkedListNode[T] {
+	// If the target index is after the halfway point
+	// we can traverse backwards to find the node
+	if index > list.length/2 {
+		currentNode := list.tail
+		for range list.length - index - 1 {
+			currentNode = currentNode.prev
+		}
+		return currentNode
+	}
+
+	currentNode := list.head
+	for range index {
+		currentNode = currentNode.next
+	}
+	return currentNode
+}
+
+// Determine if the given node belongs to this list.
+func (list *LinkedList[T]) owns(node *LinkedListNode[T]) bool {
+	return node != nil && node.ownerList() == list
+}
+
+// ----------------------------------------------------------------------------
+// Add methods
+
+// Add a new item to the end of the list.
+func (list *LinkedList[T]) Add(item T) {
+	list.linkAfter(&LinkedListNode[T]{item: item}, list.tail)
 }
 
 // Add a new item to the list in the specified position.
@@ -332,7 +389,7 @@ func (list *LinkedList[T]) Add(item T) {
 // ````
 func (list *LinkedList[T]) AddAtIndex(item T, index int) error {
 	// Note here we allow list.length==index, as we *can* insert at the end of the list
-	if list.length < index {
+	if index < 0 || list.length < index {
 		return dsa_error.ErrorIndexOutOfBounds
 	}
 
@@ -340,55 +397,114 @@ func (list *LinkedList[T]) AddAtIndex(item T, index int) error {
 		item: item,
 	}
 
-	// If we are inserting at the head of the list (index=0)
-	// we have a special case, as we splice into
-	// list.head rather than node.next
-	if index == 0 {
-		// Set the list pointers correctly
-		newNode.next = list.head
-		list.head.prev = newNode
+	// Inserting at the end of the list is inserting after the tail
+	if index == list.length {
+		list.linkAfter(newNode, list.tail)
+		return nil
+	}
+
+	// Otherwise we insert after the node currently before the target index.
+	// This is nil if we are inserting at the head, which linkAfter handles
+	list.linkAfter(newNode, list.nodeAtIndex(index).prev)
+	return nil
+}
+
+// Insert a new item immediately before the given node of this list.
+//
+// Returns the node holding the new item, or an ErrorNodeNotInList if the given node does not belong to this list.
+func (list *LinkedList[T]) InsertBefore(item T, mark *LinkedListNode[T]) (*LinkedListNode[T], error) {
+	if !list.owns(mark) {
+		return nil, ErrorNodeNotInList
+	}
+
+	newNode := &LinkedListNode[T]{
+		item: item,
+	}
+	list.linkAfter(newNode, mark.prev)
+	return newNode, nil
+}
+
+// Insert a new item immediately after the given node of this list.
+//
+// Returns the node holding the new item, or an ErrorNodeNotInList if the given node does not belong to this list.
+func (list *LinkedList[T]) InsertAfter(item T, mark *LinkedListNode[T]) (*LinkedListNode[T], error) {
+	if !list.owns(mark) {
+		return nil, ErrorNodeNotInList
+	}
+
+	newNode := &LinkedListNode[T]{
+		item: item,
+	}
+	list.linkAfter(newNode, mark)
+	return newNode, nil
+}
+
+// ----------------------------------------------------------------------------
+// Move methods
 
-		list.head = newNode
-		list.length += 1
+// Move the given node of this list to the front of the list.
+//
+// Returns an ErrorNodeNotInList if the given node does not belong to this list.
+func (list *LinkedList[T]) MoveToFront(node *LinkedListNode[T]) error {
+	if !list.owns(node) {
+		return ErrorNodeNotInList
+	}
+	if list.head == node {
 		return nil
 	}
 
-	// If we are inserting at the tail of the list,
-	// we have another special case. Here can can just call
-	// list.Add()
-	if index == list.length {
-		list.Add(item)
+	list.unlink(node)
+	list.linkAfter(node, nil)
+	return nil
+}
+
+// Move the given node of this list to the back of the list.
+//
+// Returns an ErrorNodeNotInList if the given node does not belong to this list.
+func (list *LinkedList[T]) MoveToBack(node *LinkedListNode[T]) error {
+	if !list.owns(node) {
+		return ErrorNodeNotInList
+	}
+	if list.tail == node {
 		return nil
 	}
 
-	// Otherwise we have to do some traversal.
-	// Let's find the before and after splice nodes
-	var beforeSpliceNode *LinkedListNode[T]
-	var afterSpliceNode *LinkedListNode[T]
-	if index > list.length/2 {
-		currentNode := list.tail
-		for range list.length - index - 1 {
-			currentNode = currentNode.prev
-		}
-		beforeSpliceNode = currentNode.prev
-		afterSpliceNode = currentNode
-	} else {
-		currentNode := list.head
-		for range index - 1 {
-			currentNode = currentNode.next
-		}
-		beforeSpliceNode = currentNode
-		afterSpliceNode = currentNode.next
+	list.unlink(node)
+	list.linkAfter(node, list.tail)
+	return nil
+}
+
+// Move the given node of this list to immediately before mark, another node of this list.
+// If node and mark are the same node, the list is unchanged.
+//
+// Returns an ErrorNodeNotInList if either node does not belong to this list.
+func (list *LinkedList[T]) MoveBefore(node *LinkedListNode[T], mark *LinkedListNode[T]) error {
+	if !list.owns(node) || !list.owns(mark) {
+		return ErrorNodeNotInList
+	}
+	if node == mark || node.next == mark {
+		return nil
 	}
 
-	// And set the pointers correctly
-	beforeSpliceNode.next = newNode
-	newNode.prev = beforeSpliceNode
-	afterSpliceNode.prev = newNode
-	newNode.next = afterSpliceNode
+	list.unlink(node)
+	list.linkAfter(node, mark.prev)
+	return nil
+}
 
-	list.length += 1
+// Move the given node of this list to immediately after mark, another node of this list.
+// If node and mark are the same node, the list is unchanged.
+//
+// Returns an ErrorNodeNotInList if either node does not belong to this list.
+func (list *LinkedList[T]) MoveAfter(node *LinkedListNode[T], mark *LinkedListNode[T]) error {
+	if !list.owns(node) || !list.owns(mark) {
+		return ErrorNodeNotInList
+	}
+	if node == mark || node.prev == mark {
+		return nil
+	}
 
+	list.unlink(node)
+	list.linkAfter(node, mark)
 	return nil
 }
 
@@ -405,24 +521,8 @@ func (list *LinkedList[T]) Remove() (T, error) {
 		return *new(T), dsa_error.ErrorDataStructureEmpty
 	}
 
-	// If we have only one element, we must remove both head *and* tail
-	if list.length == 1 {
-		removedNode := list.head
-		list.head = nil
-		list.tail = nil
-		list.length -= 1
-		return removedNode.item, nil
-	}
-
 	removedNode := list.tail
-	newTail := list.tail.prev
-
-	list.tail = newTail
-	removedNode.next = nil
-	removedNode.prev = nil
-	newTail.next = nil
-
-	list.length -= 1
+	list.unlink(removedNode)
 	return removedNode.item, nil
 }
 
@@ -457,59 +557,352 @@ func (list *LinkedList[T]) RemoveAtIndex(index int) (T, error) {
 
 	// Note here we do not allow RemoveAtIndex(list.Length()) as this is "out of bounds"
 	// and unlike inserting it does not make sense to define it here.
-	if list.length <= index {
+	if index < 0 || list.length <= index {
 		return *new(T), dsa_error.ErrorIndexOutOfBounds
 	}
 
-	// If we are removing at the tail of the list,
-	// we have another special case. Here can can just call
-	// list.Remove()
-	if index == list.length-1 {
-		return list.Remove()
+	removedNode := list.nodeAtIndex(index)
+	list.unlink(removedNode)
+	return removedNode.item, nil
+}
+
+// Remove the given node from this list, returning its item.
+//
+// After removal the node no longer belongs to any list, and its Next and Previous are nil.
+//
+// Returns an ErrorNodeNotInList if the given node does not belong to this list.
+func (list *LinkedList[T]) RemoveNode(node *LinkedListNode[T]) (T, error) {
+	if !list.owns(node) {
+		return *new(T), ErrorNodeNotInList
 	}
 
-	// If we are removing at the head of the list (index=0)
-	// we have a special case, as we splice into
-	// list.head rather than node.next
-	if index == 0 {
-		removedNode := list.head
-		// Set the list pointers correctly
-		list.head = removedNode.next
-		list.head.prev = nil
+	list.unlink(node)
+	return node.item, nil
+}
 
-		list.length -= 1
-		return removedNode.item, nil
+// Remove and return the first item in the list satisfying a predicate function.
+// If no item satisfies the predicate, a dsa_error.ErrorItemNotFound is returned instead.
+//
+// The list is walked forward during this search.
+func (list *LinkedList[T]) RemoveFirst(predicate func(item T) bool) (T, error) {
+	for node := list.head; node != nil; node = node.next {
+		if predicate(node.item) {
+			list.unlink(node)
+			return node.item, nil
+		}
 	}
 
-	// Otherwise we have to do some traversal.
-	// Let's find the before and after splice nodes
-	var beforeSpliceNode *LinkedListNode[T]
-	var afterSpliceNode *LinkedListNode[T]
-	var removedNode *LinkedListNode[T]
-	if index > list.length/2 {
-		currentNode := list.tail
-		for range list.length - index - 2 {
-			currentNode = currentNode.prev
+	return *new(T), dsa_error.ErrorItemNotFound
+}
+
+// Remove and return the last item in the list satisfying a predicate function.
+// If no item satisfies the predicate, a dsa_error.ErrorItemNotFound is returned instead.
+//
+// The list is walked backward during this search.
+func (list *LinkedList[T]) ReverseRemoveFirst(predicate func(item T) bool) (T, error) {
+	for node := list.tail; node != nil; node = node.prev {
+		if predicate(node.item) {
+			list.unlink(node)
+			return node.item, nil
 		}
-		afterSpliceNode = currentNode
-		removedNode = currentNode.prev
-		beforeSpliceNode = removedNode.prev
+	}
+
+	return *new(T), dsa_error.ErrorItemNotFound
+}
+
+// Remove ALL items in the list satisfying a predicate, keeping the remaining items in order.
+// The list is walked forward once, testing each item exactly once.
+//
+// Returns the number of items removed.
+func (list *LinkedList[T]) RemoveAll(predicate func(item T) bool) int {
+	numRemoved := 0
+	for node := list.head; node != nil; {
+		nextNode := node.next
+		if predicate(node.item) {
+			list.unlink(node)
+			numRemoved += 1
+		}
+		node = nextNode
+	}
+
+	return numRemoved
+}
+
+// Keep only the items in the list satisfying a predicate, removing all others and keeping the remaining items in order.
+// The list is walked forward once, testing each item exactly once.
+//
+// Returns the number of items removed.
+func (list *LinkedList[T]) Retain(predicate func(item T) bool) int {
+	return list.RemoveAll(func(item T) bool {
+		return !predicate(item)
+	})
+}
+
+// ----------------------------------------------------------------------------
+// Bulk methods
+//
+// Methods operating on whole runs of nodes, relinking nodes rather than copying items.
+
+// Move all nodes of other into this list immediately after mark. If mark is nil, the nodes are moved to the head.
+//
+// other is left empty, and the moved nodes now belong to this list. This is O(1).
+func (list *LinkedList[T]) stealAfter(other *LinkedList[T], mark *LinkedListNode[T]) {
+	if other.length == 0 {
+		return
+	}
+
+	first := other.head
+	last := other.tail
+	first.prev = mark
+	if mark == nil {
+		last.next = list.head
+		list.head = first
 	} else {
-		currentNode := list.head
-		for range index - 1 {
-			currentNode = currentNode.next
+		last.next = mark.next
+		mark.next = first
+	}
+
+	if last.next == nil {
+		list.tail = last
+	} else {
+		last.next.prev = last
+	}
+	list.length += other.length
+
+	// Forward the old owner of the moved nodes to this list, and give other a fresh owner
+	other.owner.forward = list.owner
+	other.owner = &nodeOwner[T]{list: other}
+	other.head = nil
+	other.tail = nil
+	other.length = 0
+}
+
+// Move all items of other to the end of this list. This is O(1), as the nodes of other are relinked rather than copied.
+//
+// After concatenation other is empty, and nodes previously from other now belong to this list.
+//
+// Returns an ErrorSelfSplice if other is this list.
+//
+// Example:
+//
+// ```
+//
+// list.Items()			// [1, 2]
+//
+// other.Items()		// [3, 4]
+//
+// list.Concat(other)	// list = [1, 2, 3, 4], other = []
+//
+// ```
+func (list *LinkedList[T]) Concat(other *LinkedList[T]) error {
+	if other == list {
+		return ErrorSelfSplice
+	}
+
+	list.stealAfter(other, list.tail)
+	return nil
+}
+
+// Move all items of other into this list, such that the first item of other is at the specified index.
+// This is O(index), as only the insertion point must be found, and the nodes of other are relinked rather than copied.
+//
+// After splicing other is empty, and nodes previously from other now belong to this list.
+//
+// Returns a dsa_error.ErrorIndexOutOfBounds if the index is out of bounds (note index == Length() is valid, and is equivalent to Concat),
+// or an ErrorSelfSplice if other is this list.
+//
+// Example:
+//
+// ```
+//
+// list.Items()			// [1, 4]
+//
+// other.Items()		// [2, 3]
+//
+// list.Splice(1, other)	// list = [1, 2, 3, 4], other = []
+//
+// ```
+func (list *LinkedList[T]) Splice(index int, other *LinkedList[T]) error {
+	if index < 0 || list.length < index {
+		return dsa_error.ErrorIndexOutOfBounds
+	}
+	if other == list {
+		return ErrorSelfSplice
+	}
+
+	if index == list.length {
+		list.stealAfter(other, list.tail)
+	} else {
+		list.stealAfter(other, list.nodeAtIndex(index).prev)
+	}
+	return nil
+}
+
+// Split this list in two at the specified index. This list keeps the items before the index,
+// and the items from the index onwards are moved to a new list, which is returned.
+//
+// Nodes are relinked rather than copied, and the nodes moved to the new list now belong to the new list.
+// This is O(min(index, Length()-index)).
+//
+// Returns a dsa_error.ErrorIndexOutOfBounds if the index is out of bounds (note index == Length() is valid, and returns an empty list).
+//
+// Example:
+//
+// ```
+//
+// list.Items()					// [1, 2, 3, 4]
+//
+// newList, err := list.SplitAt(1)	// list = [1], newList = [2, 3, 4]
+//
+// ```
+func (list *LinkedList[T]) SplitAt(index int) (*LinkedList[T], error) {
+	if index < 0 || list.length < index {
+		return nil, dsa_error.ErrorIndexOutOfBounds
+	}
+
+	newList := New[T]()
+	if index == list.length {
+		return newList, nil
+	}
+
+	firstMovedNode := list.nodeAtIndex(index)
+	lastKeptNode := firstMovedNode.prev
+
+	newList.head = firstMovedNode
+	newList.tail = list.tail
+	newList.length = list.length - index
+	firstMovedNode.prev = nil
+
+	if lastKeptNode == nil {
+		list.head = nil
+		list.tail = nil
+	} else {
+		lastKeptNode.next = nil
+		list.tail = lastKeptNode
+	}
+	list.length = index
+
+	// Only relabel the owner of the shorter part.
+	// If the kept part is shorter, the existing owner (and anything forwarded to it) is handed to the new list instead.
+	if list.length < newList.length {
+		newList.owner = list.owner
+		newList.owner.list = newList
+		list.owner = &nodeOwner[T]{list: list}
+		for node := list.head; node != nil; node = node.next {
+			node.owner = list.owner
+		}
+	} else {
+		for node := newList.head; node != nil; node = node.next {
+			node.owner = newList.owner
 		}
-		beforeSpliceNode = currentNode
-		removedNode = beforeSpliceNode.next
-		afterSpliceNode = removedNode.next
 	}
 
-	// And set the pointers correctly
-	beforeSpliceNode.next = afterSpliceNode
-	afterSpliceNode.prev = beforeSpliceNode
-	removedNode.next = nil
-	removedNode.prev = nil
+	return newList, nil
+}
 
-	list.length -= 1
-	return removedNode.item, nil
+// Reverse the order of the items in this list, in place. Nodes are relinked rather than copied.
+func (list *LinkedList[T]) Reverse() {
+	// After swapping, the old next node is found at node.prev
+	for node := list.head; node != nil; node = node.prev {
+		node.next, node.prev = node.prev, node.next
+	}
+	list.head, list.tail = list.tail, list.head
+}
+
+// Sort the items of this list in place, using a merge sort on the nodes.
+//
+// The sort is stable: items comparing as equal keep their relative order. This is O(n log n) time,
+// and nodes are relinked rather than copied, so node handles remain valid.
+func (list *LinkedList[T]) Sort(comparatorFunction comparator.ComparatorFunction[T]) {
+	if list.length < 2 {
+		return
+	}
+
+	list.head = mergeSortNodes(list.head, list.length, comparatorFunction)
+
+	// The merge sort only maintains next pointers, so restore the previous pointers and tail
+	var previousNode *LinkedListNode[T]
+	for node := list.head; node != nil; node = node.next {
+		node.prev = previousNode
+		previousNode = node
+	}
+	list.tail = previousNode
+}
+
+// Sort a chain of nodes of the given length, linked by next pointers and terminated by nil.
+//
+// Returns the head of the sorted chain. Previous pointers are not maintained.
+func mergeSortNodes[T any](head *LinkedListNode[T], length int, comparatorFunction comparator.ComparatorFunction[T]) *LinkedListNode[T] {
+	if length <= 1 {
+		return head
+	}
+
+	// Cut the chain in half
+	leftLength := length / 2
+	leftTail := head
+	for range leftLength - 1 {
+		leftTail = leftTail.next
+	}
+	rightHead := leftTail.next
+	leftTail.next = nil
+
+	left := mergeSortNodes(head, leftLength, comparatorFunction)
+	right := mergeSortNodes(rightHead, length-leftLength, comparatorFunction)
+
+	// Merge, taking from the left chain on ties to keep the sort stable
+	var sentinel LinkedListNode[T]
+	mergedTail := &sentinel
+	for left != nil && right != nil {
+		if comparatorFunction(left.item, right.item) <= 0 {
+			mergedTail.next = left
+			left = left.next
+		} else {
+			mergedTail.next = right
+			right = right.next
+		}
+		mergedTail = mergedTail.next
+	}
+	if left != nil {
+		mergedTail.next = left
+	} else {
+		mergedTail.next = right
+	}
+
+	return sentinel.next
+}
+
+// Remove consecutive duplicate items from this list, keeping the first of each run.
+//
+// Each item is compared against the most recently kept item using isDuplicate(kept, item),
+// and is removed if this returns true. To remove all duplicates (not only consecutive ones), Sort the list first.
+//
+// Returns the number of items removed.
+//
+// Example:
+//
+// ```
+//
+// list.Items()	// [1, 1, 2, 3, 3, 3, 1]
+//
+// list.Dedup(func(kept, item int) bool { return kept == item })	// returns 3, list = [1, 2, 3, 1]
+//
+// ```
+func (list *LinkedList[T]) Dedup(isDuplicate func(kept T, item T) bool) int {
+	if list.length < 2 {
+		return 0
+	}
+
+	numRemoved := 0
+	keptNode := list.head
+	for node := keptNode.next; node != nil; {
+		nextNode := node.next
+		if isDuplicate(keptNode.item, node.item) {
+			list.unlink(node)
+			numRemoved += 1
+		} else {
+			keptNode = node
+		}
+		node = nextNode
+	}
+	return numRemoved
 }
@@ -0,0 +1,279 @@
+package linkedlist_test
+
+import (
+	"errors"
+	"math/rand"
+	"slices"
+	"testing"
+
+	linkedlist "github.com/hmcalister/Go-DSA/list/LinkedList"
+	comparator "github.com/hmcalister/Go-DSA/utils/Comparator"
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
+)
+
+func newLinkedListFromItems[T any](items []T) *linkedlist.LinkedList[T] {
+	list := linkedlist.New[T]()
+	for _, item := range items {
+		list.Add(item)
+	}
+	return list
+}
+
+func TestConcat(t *testing.T) {
+	testCases := []struct {
+		name       string
+		listItems  []int
+		otherItems []int
+	}{
+		{"both empty", []int{}, []int{}},
+		{"list empty", []int{}, []int{3, 4}},
+		{"other empty", []int{1, 2}, []int{}},
+		{"both non-empty", []int{1, 2}, []int{3, 4, 5}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			list := newLinkedListFromItems(testCase.listItems)
+			other := newLinkedListFromItems(testCase.otherItems)
+			if err := list.Concat(other); err != nil {
+				t.Fatalf("error when concatenating lists: %v", err)
+			}
+			checkLinkedListItems(t, list, slices.Concat(testCase.listItems, testCase.otherItems))
+			checkLinkedListItems(t, other, []int{})
+
+			// other must still be usable after being emptied
+			other.Add(10)
+			checkLinkedListItems(t, other, []int{10})
+		})
+	}
+}
+
+func TestConcatSelf(t *testing.T) {
+	list := newLinkedListFromItems([]int{1, 2})
+	if err := list.Concat(list); !errors.Is(err, linkedlist.ErrorSelfSplice) {
+		t.Errorf("expected ErrorSelfSplice when concatenating list with itself, found (%v)", err)
+	}
+	checkLinkedListItems(t, list, []int{1, 2})
+}
+
+func TestConcatTransfersNodeOwnership(t *testing.T) {
+	list := newLinkedListFromItems([]int{1, 2})
+	other := newLinkedListFromItems([]int{3, 4})
+	movedNode := other.Front()
+
+	list.Concat(other)
+
+	if _, err := other.RemoveNode(movedNode); !errors.Is(err, linkedlist.ErrorNodeNotInList) {
+		t.Errorf("expected ErrorNodeNotInList when removing moved node from old list, found (%v)", err)
+	}
+	if _, err := list.RemoveNode(movedNode); err != nil {
+		t.Errorf("error when removing moved node from new list: %v", err)
+	}
+	checkLinkedListItems(t, list, []int{1, 2, 4})
+
+	// Nodes added to other after concatenation belong to other, not list
+	other.Add(5)
+	if _, err := list.RemoveNode(other.Front()); !errors.Is(err, linkedlist.ErrorNodeNotInList) {
+		t.Errorf("expected ErrorNodeNotInList when removing node of other list, found (%v)", err)
+	}
+
+	// Ownership must follow repeated concatenations
+	third := newLinkedListFromItems([]int{0})
+	third.Concat(list)
+	if err := third.MoveToFront(third.Back()); err != nil {
+		t.Errorf("error when moving node after repeated concatenation: %v", err)
+	}
+	checkLinkedListItems(t, third, []int{4, 0, 1, 2})
+}
+
+func TestSplice(t *testing.T) {
+	for index := range 4 {
+		list := newLinkedListFromItems([]int{0, 1, 2})
+		other := newLinkedListFromItems([]int{10, 11})
+		if err := list.Splice(index, other); err != nil {
+			t.Fatalf("error when splicing at index (%v): %v", index, err)
+		}
+
+		expectedItems := slices.Insert([]int{0, 1, 2}, index, 10, 11)
+		checkLinkedListItems(t, list, expectedItems)
+		checkLinkedListItems(t, other, []int{})
+	}
+}
+
+func TestSpliceErrors(t *testing.T) {
+	list := newLinkedListFromItems([]int{0, 1, 2})
+	other := newLinkedListFromItems([]int{10})
+
+	if err := list.Splice(4, other); !errors.Is(err, dsa_error.ErrorIndexOutOfBounds) {
+		t.Errorf("expected ErrorIndexOutOfBounds when splicing past end of list, found (%v)", err)
+	}
+	if err := list.Splice(-1, other); !errors.Is(err, dsa_error.ErrorIndexOutOfBounds) {
+		t.Errorf("expected ErrorIndexOutOfBounds when splicing at negative index, found (%v)", err)
+	}
+	if err := list.Splice(1, list); !errors.Is(err, linkedlist.ErrorSelfSplice) {
+		t.Errorf("expected ErrorSelfSplice when splicing list into itself, found (%v)", err)
+	}
+	checkLinkedListItems(t, list, []int{0, 1, 2})
+	checkLinkedListItems(t, other, []int{10})
+}
+
+func TestSplitAt(t *testing.T) {
+	items := []int{0, 1, 2, 3, 4, 5}
+	for index := range len(items) + 1 {
+		list := newLinkedListFromItems(items)
+		nodes := make([]*linkedlist.LinkedListNode[int], 0)
+		for node := list.Front(); node != nil; node = node.Next() {
+			nodes = append(nodes, node)
+		}
+
+		newList, err := list.SplitAt(index)
+		if err != nil {
+			t.Fatalf("error when splitting at index (%v): %v", index, err)
+		}
+		checkLinkedListItems(t, list, items[:index])
+		checkLinkedListItems(t, newList, items[index:])
+
+		// Each node must belong to exactly the list holding its item
+		for nodeIndex, node := range nodes {
+			owningList, otherList := list, newList
+			if nodeIndex >= index {
+				owningList, otherList = newList, list
+			}
+			if err := otherList.MoveToFront(node); !errors.Is(err, linkedlist.ErrorNodeNotInList) {
+				t.Errorf("split at (%v): expected node (%v) to not belong to other list, found (%v)", index, node.Item(), err)
+			}
+			if err := owningList.MoveToBack(node); err != nil {
+				t.Errorf("split at (%v): expected node (%v) to belong to owning list, found (%v)", index, node.Item(), err)
+			}
+		}
+	}
+}
+
+func TestSplitAtAfterConcat(t *testing.T) {
+	// Nodes whose ownership was forwarded by Concat must be correctly relabelled by SplitAt
+	for index := range 7 {
+		list := newLinkedListFromItems([]int{0, 1})
+		list.Concat(newLinkedListFromItems([]int{2, 3, 4, 5}))
+		nodes := make([]*linkedlist.LinkedListNode[int], 0)
+		for node := list.Front(); node != nil; node = node.Next() {
+			nodes = append(nodes, node)
+		}
+
+		newList, _ := list.SplitAt(index)
+		for nodeIndex, node := range nodes {
+			owningList := list
+			if nodeIndex >= index {
+				owningList = newList
+			}
+			if _, err := owningList.InsertAfter(-1, node); err != nil {
+				t.Errorf("split at (%v): expected node (%v) to belong to owning list, found (%v)", index, node.Item(), err)
+			}
+		}
+	}
+}
+
+func TestSplitAtOutOfBounds(t *testing.T) {
+	list := newLinkedListFromItems([]int{0, 1, 2})
+	if _, err := list.SplitAt(4); !errors.Is(err, dsa_error.ErrorIndexOutOfBounds) {
+		t.Errorf("expected ErrorIndexOutOfBounds when splitting past end of list, found (%v)", err)
+	}
+	if _, err := list.SplitAt(-1); !errors.Is(err, dsa_error.ErrorIndexOutOfBounds) {
+		t.Errorf("expected ErrorIndexOutOfBounds when splitting at negative index, found (%v)", err)
+	}
+	checkLinkedListItems(t, list, []int{0, 1, 2})
+}
+
+func TestReverse(t *testing.T) {
+	for length := range 6 {
+		items := make([]int, length)
+		for index := range items {
+			items[index] = index
+		}
+		list := newLinkedListFromItems(items)
+		list.Reverse()
+
+		slices.Reverse(items)
+		checkLinkedListItems(t, list, items)
+	}
+}
+
+func TestSort(t *testing.T) {
+	randomGenerator := rand.New(rand.NewSource(0))
+	for length := range 50 {
+		items := make([]int, length)
+		for index := range items {
+			items[index] = randomGenerator.Intn(20)
+		}
+		list := newLinkedListFromItems(items)
+		list.Sort(comparator.DefaultIntegerComparator)
+
+		slices.Sort(items)
+		checkLinkedListItems(t, list, items)
+	}
+}
+
+func TestSortIsStable(t *testing.T) {
+	type keyedItem struct {
+		key   int
+		order int
+	}
+	randomGenerator := rand.New(rand.NewSource(0))
+	items := make([]keyedItem, 200)
+	for index := range items {
+		items[index] = keyedItem{key: randomGenerator.Intn(5), order: index}
+	}
+	compareKeys := func(a, b keyedItem) int {
+		return a.key - b.key
+	}
+
+	list := newLinkedListFromItems(items)
+	list.Sort(compareKeys)
+
+	slices.SortStableFunc(items, compareKeys)
+	checkLinkedListItems(t, list, items)
+}
+
+func TestSortKeepsNodeHandles(t *testing.T) {
+	list := newLinkedListFromItems([]int{3, 1, 2})
+	node := list.Front()
+	list.Sort(comparator.DefaultIntegerComparator)
+
+	if list.Back() != node {
+		t.Errorf("expected node of largest item to be moved to the back of the list")
+	}
+	list.RemoveNode(node)
+	checkLinkedListItems(t, list, []int{1, 2})
+}
+
+func TestDedup(t *testing.T) {
+	isEqual := func(kept, item int) bool {
+		return kept == item
+	}
+	testCases := []struct {
+		items           []int
+		expectedItems   []int
+		expectedRemoved int
+	}{
+		{[]int{}, []int{}, 0},
+		{[]int{1}, []int{1}, 0},
+		{[]int{1, 1, 1}, []int{1}, 2},
+		{[]int{1, 1, 2, 3, 3, 3, 1}, []int{1, 2, 3, 1}, 3},
+		{[]int{1, 2, 3}, []int{1, 2, 3}, 0},
+	}
+	for _, testCase := range testCases {
+		list := newLinkedListFromItems(testCase.items)
+		numRemoved := list.Dedup(isEqual)
+		if numRemoved != testCase.expectedRemoved {
+			t.Errorf("found number removed (%v) does not match expected number removed (%v) for items (%v)", numRemoved, testCase.expectedRemoved, testCase.items)
+		}
+		checkLinkedListItems(t, list, testCase.expectedItems)
+	}
+}
+
+func TestDedupComparesAgainstKeptItem(t *testing.T) {
+	// Items within 1 of the kept item are duplicates, so a slowly increasing run collapses in steps
+	list := newLinkedListFromItems([]int{0, 1, 2, 3, 4, 5})
+	list.Dedup(func(kept, item int) bool {
+		return item-kept <= 1
+	})
+	checkLinkedListItems(t, list, []int{0, 2, 4})
+}
@@ -0,0 +1,211 @@
+package linkedlist_test
+
+import (
+	"errors"
+	"testing"
+
+	linkedlist "github.com/hmcalister/Go-DSA/list/LinkedList"
+)
+
+func TestCursorEmptyList(t *testing.T) {
+	list := linkedlist.New[int]()
+	for _, cursor := range []*linkedlist.Cursor[int]{list.CursorFront(), list.CursorBack()} {
+		if cursor.Valid() {
+			t.Errorf("expected cursor over empty list to be invalid")
+		}
+		if _, err := cursor.Item(); !errors.Is(err, linkedlist.ErrorCursorAtGhost) {
+			t.Errorf("expected ErrorCursorAtGhost from Item, found (%v)", err)
+		}
+		if err := cursor.Set(1); !errors.Is(err, linkedlist.ErrorCursorAtGhost) {
+			t.Errorf("expected ErrorCursorAtGhost from Set, found (%v)", err)
+		}
+		if _, err := cursor.Remove(); !errors.Is(err, linkedlist.ErrorCursorAtGhost) {
+			t.Errorf("expected ErrorCursorAtGhost from Remove, found (%v)", err)
+		}
+		if err := cursor.Next(); err != nil || cursor.Valid() {
+			t.Errorf("expected cursor over empty list to stay at ghost position, found (%v, %v)", cursor.Valid(), err)
+		}
+	}
+}
+
+func TestCursorForwardBackward(t *testing.T) {
+	items := []int{0, 1, 2, 3}
+	list := newLinkedListFromItems(items)
+
+	cursor := list.CursorFront()
+	for _, expected := range items {
+		item, err := cursor.Item()
+		if err != nil || item != expected {
+			t.Errorf("found item (%v, %v) does not match expected item (%v, %v)", item, err, expected, nil)
+		}
+		cursor.Next()
+	}
+	if cursor.Valid() {
+		t.Errorf("expected cursor past back of list to be at ghost position")
+	}
+
+	// From the ghost position, moving forward wraps to the front
+	cursor.Next()
+	if item, _ := cursor.Item(); item != 0 {
+		t.Errorf("found item (%v) does not match expected item (%v)", item, 0)
+	}
+
+	// Moving backward from the front reaches the ghost position, then the back
+	cursor.Previous()
+	if cursor.Valid() {
+		t.Errorf("expected cursor before front of list to be at ghost position")
+	}
+	for expected := len(items) - 1; expected >= 0; expected -= 1 {
+		cursor.Previous()
+		item, err := cursor.Item()
+		if err != nil || item != expected {
+			t.Errorf("found item (%v, %v) does not match expected item (%v, %v)", item, err, expected, nil)
+		}
+	}
+
+	backCursor := list.CursorBack()
+	if item, _ := backCursor.Item(); item != 3 {
+		t.Errorf("found item (%v) does not match expected item (%v)", item, 3)
+	}
+	if backCursor.Node() != list.Back() {
+		t.Errorf("expected back cursor node to be the back node of the list")
+	}
+}
+
+func TestCursorSet(t *testing.T) {
+	list := newLinkedListFromItems([]int{0, 1, 2, 3})
+	for cursor := list.CursorFront(); cursor.Valid(); cursor.Next() {
+		item, _ := cursor.Item()
+		cursor.Set(item * 10)
+	}
+	checkLinkedListItems(t, list, []int{0, 10, 20, 30})
+}
+
+func TestCursorInsert(t *testing.T) {
+	list := newLinkedListFromItems([]int{1, 3})
+
+	cursor := list.CursorFront()
+	cursor.InsertBefore(0)
+	cursor.InsertAfter(2)
+	if item, _ := cursor.Item(); item != 1 {
+		t.Errorf("expected cursor to stay on item (%v), found (%v)", 1, item)
+	}
+	checkLinkedListItems(t, list, []int{0, 1, 2, 3})
+
+	// At the ghost position, InsertBefore adds to the back and InsertAfter adds to the front
+	ghostCursor := list.CursorBack()
+	ghostCursor.Next()
+	ghostCursor.InsertBefore(4)
+	ghostCursor.InsertAfter(-1)
+	checkLinkedListItems(t, list, []int{-1, 0, 1, 2, 3, 4})
+}
+
+func TestCursorRemove(t *testing.T) {
+	list := newLinkedListFromItems([]int{-1, 0, -2, -3, 1, 2, -4})
+	for cursor := list.CursorFront(); cursor.Valid(); {
+		item, _ := cursor.Item()
+		if item < 0 {
+			removedItem, err := cursor.Remove()
+			if err != nil || removedItem != item {
+				t.Errorf("found removed item (%v, %v) does not match expected item (%v, %v)", removedItem, err, item, nil)
+			}
+		} else {
+			cursor.Set(2 * item)
+			cursor.Next()
+		}
+	}
+	checkLinkedListItems(t, list, []int{0, 2, 4})
+}
+
+func TestCursorRemoveBackward(t *testing.T) {
+	list := newLinkedListFromItems([]int{0, 1, 2})
+	cursor := list.CursorBack()
+	cursor.Remove()
+	if cursor.Valid() {
+		t.Errorf("expected cursor to move to ghost position after removing the back item")
+	}
+	cursor.Previous()
+	if item, _ := cursor.Item(); item != 1 {
+		t.Errorf("found item (%v) does not match expected item (%v)", item, 1)
+	}
+	checkLinkedListItems(t, list, []int{0, 1})
+}
+
+func TestCursorModifiedByOtherCursor(t *testing.T) {
+	list := newLinkedListFromItems([]int{0, 1, 2, 3})
+	first := list.CursorFront()
+	second := list.CursorFront()
+	second.Next()
+
+	// Edits around a cursor do not affect it
+	first.InsertAfter(10)
+	if item, _ := second.Item(); item != 1 {
+		t.Errorf("found item (%v) does not match expected item (%v)", item, 1)
+	}
+	second.Previous()
+	if item, _ := second.Item(); item != 10 {
+		t.Errorf("found item (%v) does not match expected item (%v)", item, 10)
+	}
+
+	// Removing the item under another cursor invalidates that cursor
+	first.Next()
+	first.Remove()
+	if second.Valid() {
+		t.Errorf("expected cursor on removed item to be invalid")
+	}
+	if _, err := second.Item(); !errors.Is(err, linkedlist.ErrorCursorInvalidated) {
+		t.Errorf("expected ErrorCursorInvalidated from Item, found (%v)", err)
+	}
+	if err := second.Set(5); !errors.Is(err, linkedlist.ErrorCursorInvalidated) {
+		t.Errorf("expected ErrorCursorInvalidated from Set, found (%v)", err)
+	}
+	if err := second.Next(); !errors.Is(err, linkedlist.ErrorCursorInvalidated) {
+		t.Errorf("expected ErrorCursorInvalidated from Next, found (%v)", err)
+	}
+	if err := second.Previous(); !errors.Is(err, linkedlist.ErrorCursorInvalidated) {
+		t.Errorf("expected ErrorCursorInvalidated from Previous, found (%v)", err)
+	}
+	if err := second.InsertBefore(5); !errors.Is(err, linkedlist.ErrorCursorInvalidated) {
+		t.Errorf("expected ErrorCursorInvalidated from InsertBefore, found (%v)", err)
+	}
+	if err := second.InsertAfter(5); !errors.Is(err, linkedlist.ErrorCursorInvalidated) {
+		t.Errorf("expected ErrorCursorInvalidated from InsertAfter, found (%v)", err)
+	}
+	if _, err := second.Remove(); !errors.Is(err, linkedlist.ErrorCursorInvalidated) {
+		t.Errorf("expected ErrorCursorInvalidated from Remove, found (%v)", err)
+	}
+	if second.Node() != nil {
+		t.Errorf("expected nil node from invalidated cursor")
+	}
+
+	// The cursor that removed the item is on the following item
+	if item, _ := first.Item(); item != 1 {
+		t.Errorf("found item (%v) does not match expected item (%v)", item, 1)
+	}
+	checkLinkedListItems(t, list, []int{0, 1, 2, 3})
+}
+
+func TestCursorInvalidatedByListMethods(t *testing.T) {
+	list := newLinkedListFromItems([]int{0, 1, 2, 3})
+	cursor := list.CursorBack()
+	list.Remove()
+	if cursor.Valid() {
+		t.Errorf("expected cursor on removed item to be invalid")
+	}
+
+	// A cursor whose node is moved to another list is also invalidated
+	cursor = list.CursorBack()
+	list.SplitAt(1)
+	if _, err := cursor.Item(); !errors.Is(err, linkedlist.ErrorCursorInvalidated) {
+		t.Errorf("expected ErrorCursorInvalidated after node moved to another list, found (%v)", err)
+	}
+
+	// A cursor whose node is still in the list follows its node when the list is reordered
+	list = newLinkedListFromItems([]int{2, 0, 1})
+	cursor = list.CursorFront()
+	list.Reverse()
+	cursor.Previous()
+	if item, _ := cursor.Item(); item != 0 {
+		t.Errorf("found item (%v) does not match expected item (%v)", item, 0)
+	}
+}
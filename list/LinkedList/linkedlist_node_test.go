@@ -0,0 +1,247 @@
+package linkedlist_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	linkedlist "github.com/hmcalister/Go-DSA/list/LinkedList"
+)
+
+// a helper method to check the items of a list, walking both forward and backward through the nodes.
+//
+// calls t.Errorf if the items, links, or length of the list do not match the expected items.
+func checkLinkedListItems[T comparable](t *testing.T, list *linkedlist.LinkedList[T], expectedItems []T) {
+	t.Helper()
+
+	if list.Length() != len(expectedItems) {
+		t.Errorf("list length %v does not match expected length %v", list.Length(), len(expectedItems))
+	}
+
+	forwardItems := make([]T, 0)
+	for node := list.Front(); node != nil; node = node.Next() {
+		forwardItems = append(forwardItems, node.Item())
+	}
+	if !slices.Equal(forwardItems, expectedItems) {
+		t.Errorf("found forward items (%v) does not match expected items (%v)", forwardItems, expectedItems)
+	}
+
+	backwardItems := make([]T, 0)
+	for node := list.Back(); node != nil; node = node.Previous() {
+		backwardItems = append(backwardItems, node.Item())
+	}
+	slices.Reverse(backwardItems)
+	if !slices.Equal(backwardItems, expectedItems) {
+		t.Errorf("found backward items (%v) does not match expected items (%v)", backwardItems, expectedItems)
+	}
+}
+
+func TestFrontBackEmptyList(t *testing.T) {
+	list := linkedlist.New[int]()
+	if list.Front() != nil {
+		t.Errorf("expected nil front node of empty list")
+	}
+	if list.Back() != nil {
+		t.Errorf("expected nil back node of empty list")
+	}
+}
+
+func TestFrontBack(t *testing.T) {
+	list := linkedlist.New[int]()
+	for item := range 5 {
+		list.Add(item)
+	}
+
+	if list.Front().Item() != 0 {
+		t.Errorf("found front item (%v) does not match expected item (%v)", list.Front().Item(), 0)
+	}
+	if list.Back().Item() != 4 {
+		t.Errorf("found back item (%v) does not match expected item (%v)", list.Back().Item(), 4)
+	}
+	if list.Front().Previous() != nil || list.Back().Next() != nil {
+		t.Errorf("expected front node to have no previous node and back node to have no next node")
+	}
+}
+
+func TestInsertBeforeAfter(t *testing.T) {
+	list := linkedlist.New[int]()
+	list.Add(2)
+
+	middle := list.Front()
+	front, err := list.InsertBefore(1, middle)
+	if err != nil {
+		t.Fatalf("error when inserting before node: %v", err)
+	}
+	back, err := list.InsertAfter(3, middle)
+	if err != nil {
+		t.Fatalf("error when inserting after node: %v", err)
+	}
+	checkLinkedListItems(t, list, []int{1, 2, 3})
+
+	if list.Front() != front || list.Back() != back {
+		t.Errorf("expected inserted nodes to become front and back of list")
+	}
+
+	list.InsertBefore(0, front)
+	list.InsertAfter(4, back)
+	list.InsertAfter(25, middle)
+	checkLinkedListItems(t, list, []int{0, 1, 2, 25, 3, 4})
+}
+
+func TestRemoveNode(t *testing.T) {
+	list := linkedlist.New[int]()
+	for item := range 5 {
+		list.Add(item)
+	}
+
+	middle := list.Front().Next().Next()
+	item, err := list.RemoveNode(middle)
+	if err != nil {
+		t.Fatalf("error when removing node: %v", err)
+	}
+	if item != 2 {
+		t.Errorf("removed item (%v) does not match expected item (%v)", item, 2)
+	}
+	if middle.Next() != nil || middle.Previous() != nil {
+		t.Errorf("expected removed node to be unlinked")
+	}
+	checkLinkedListItems(t, list, []int{0, 1, 3, 4})
+
+	list.RemoveNode(list.Front())
+	list.RemoveNode(list.Back())
+	checkLinkedListItems(t, list, []int{1, 3})
+
+	list.RemoveNode(list.Front())
+	list.RemoveNode(list.Front())
+	checkLinkedListItems(t, list, []int{})
+
+	// A removed node no longer belongs to the list, so removing it again must not change the length
+	if _, err := list.RemoveNode(middle); !errors.Is(err, linkedlist.ErrorNodeNotInList) {
+		t.Errorf("expected ErrorNodeNotInList when removing a removed node, found (%v)", err)
+	}
+	checkLinkedListItems(t, list, []int{})
+}
+
+func TestMoveToFrontBack(t *testing.T) {
+	list := linkedlist.New[int]()
+	for item := range 5 {
+		list.Add(item)
+	}
+
+	list.MoveToFront(list.Back())
+	checkLinkedListItems(t, list, []int{4, 0, 1, 2, 3})
+
+	list.MoveToBack(list.Front())
+	checkLinkedListItems(t, list, []int{0, 1, 2, 3, 4})
+
+	list.MoveToFront(list.Front().Next().Next())
+	checkLinkedListItems(t, list, []int{2, 0, 1, 3, 4})
+
+	list.MoveToBack(list.Front().Next())
+	checkLinkedListItems(t, list, []int{2, 1, 3, 4, 0})
+
+	// Moving to the current position is a no-op
+	list.MoveToFront(list.Front())
+	list.MoveToBack(list.Back())
+	checkLinkedListItems(t, list, []int{2, 1, 3, 4, 0})
+}
+
+func TestMoveBeforeAfter(t *testing.T) {
+	list := linkedlist.New[int]()
+	nodes := make([]*linkedlist.LinkedListNode[int], 0)
+	for item := range 5 {
+		list.Add(item)
+		nodes = append(nodes, list.Back())
+	}
+
+	list.MoveBefore(nodes[4], nodes[0])
+	checkLinkedListItems(t, list, []int{4, 0, 1, 2, 3})
+
+	list.MoveAfter(nodes[4], nodes[3])
+	checkLinkedListItems(t, list, []int{0, 1, 2, 3, 4})
+
+	list.MoveAfter(nodes[0], nodes[2])
+	checkLinkedListItems(t, list, []int{1, 2, 0, 3, 4})
+
+	list.MoveBefore(nodes[3], nodes[1])
+	checkLinkedListItems(t, list, []int{3, 1, 2, 0, 4})
+
+	// Moving a node relative to itself or to where it already is is a no-op
+	list.MoveBefore(nodes[2], nodes[2])
+	list.MoveAfter(nodes[2], nodes[2])
+	list.MoveBefore(nodes[1], nodes[2])
+	list.MoveAfter(nodes[2], nodes[1])
+	checkLinkedListItems(t, list, []int{3, 1, 2, 0, 4})
+}
+
+func TestNodeOwnership(t *testing.T) {
+	list := linkedlist.New[int]()
+	otherList := linkedlist.New[int]()
+	for item := range 3 {
+		list.Add(item)
+		otherList.Add(10 + item)
+	}
+	foreignNode := otherList.Front()
+	ownNode := list.Front()
+
+	if _, err := list.InsertBefore(-1, foreignNode); !errors.Is(err, linkedlist.ErrorNodeNotInList) {
+		t.Errorf("expected ErrorNodeNotInList from InsertBefore, found (%v)", err)
+	}
+	if _, err := list.InsertAfter(-1, foreignNode); !errors.Is(err, linkedlist.ErrorNodeNotInList) {
+		t.Errorf("expected ErrorNodeNotInList from InsertAfter, found (%v)", err)
+	}
+	if _, err := list.RemoveNode(foreignNode); !errors.Is(err, linkedlist.ErrorNodeNotInList) {
+		t.Errorf("expected ErrorNodeNotInList from RemoveNode, found (%v)", err)
+	}
+	if err := list.MoveToFront(foreignNode); !errors.Is(err, linkedlist.ErrorNodeNotInList) {
+		t.Errorf("expected ErrorNodeNotInList from MoveToFront, found (%v)", err)
+	}
+	if err := list.MoveToBack(foreignNode); !errors.Is(err, linkedlist.ErrorNodeNotInList) {
+		t.Errorf("expected ErrorNodeNotInList from MoveToBack, found (%v)", err)
+	}
+	if err := list.MoveBefore(foreignNode, ownNode); !errors.Is(err, linkedlist.ErrorNodeNotInList) {
+		t.Errorf("expected ErrorNodeNotInList from MoveBefore, found (%v)", err)
+	}
+	if err := list.MoveAfter(ownNode, foreignNode); !errors.Is(err, linkedlist.ErrorNodeNotInList) {
+		t.Errorf("expected ErrorNodeNotInList from MoveAfter, found (%v)", err)
+	}
+	if _, err := list.InsertAfter(-1, nil); !errors.Is(err, linkedlist.ErrorNodeNotInList) {
+		t.Errorf("expected ErrorNodeNotInList from InsertAfter with nil node, found (%v)", err)
+	}
+
+	// Neither list may have been modified
+	checkLinkedListItems(t, list, []int{0, 1, 2})
+	checkLinkedListItems(t, otherList, []int{10, 11, 12})
+}
+
+func TestNodeHandlesSurviveIndexOperations(t *testing.T) {
+	list := linkedlist.New[int]()
+	list.Add(1)
+	node := list.Front()
+
+	list.AddAtIndex(0, 0)
+	list.AddAtIndex(2, 2)
+	list.InsertAfter(15, node)
+	checkLinkedListItems(t, list, []int{0, 1, 15, 2})
+
+	list.RemoveAtIndex(0)
+	list.Remove()
+	checkLinkedListItems(t, list, []int{1, 15})
+
+	if _, err := list.RemoveNode(node); err != nil {
+		t.Errorf("error when removing node after index operations: %v", err)
+	}
+	checkLinkedListItems(t, list, []int{15})
+}
+
+func TestAddAtIndexEmptyList(t *testing.T) {
+	list := linkedlist.New[int]()
+	if err := list.AddAtIndex(1, 0); err != nil {
+		t.Errorf("error when adding at index 0 of empty list: %v", err)
+	}
+	checkLinkedListItems(t, list, []int{1})
+
+	if err := list.AddAtIndex(0, -1); err == nil {
+		t.Errorf("expected error when adding at negative index")
+	}
+}
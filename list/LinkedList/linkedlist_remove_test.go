@@ -1,10 +1,12 @@
 package linkedlist_test
 
 import (
+	"errors"
 	"slices"
 	"testing"
 
 	linkedlist "github.com/hmcalister/Go-DSA/list/LinkedList"
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
 )
 
 // a helper method to test removing items of a generic data type from a list.
@@ -230,3 +232,68 @@ func TestPointerCorrectnessAfterRemoveAtIndex(t *testing.T) {
 	})
 
 }
+
+func TestLinkedListRemoveFirst(t *testing.T) {
+	items := []int{0, 1, 2, 3, 4, 5}
+	list := linkedlist.New[int]()
+	for _, item := range items {
+		list.Add(item)
+	}
+
+	// RemoveFirst walks from the head, ReverseRemoveFirst from the tail
+	isTwoOrFour := func(item int) bool { return item == 2 || item == 4 }
+	if item, err := list.RemoveFirst(isTwoOrFour); err != nil || item != 2 {
+		t.Errorf("found removed item (%v, %v) does not match expected item (%v, %v)", item, err, 2, nil)
+	}
+	list.Add(2)
+	if item, err := list.ReverseRemoveFirst(isTwoOrFour); err != nil || item != 2 {
+		t.Errorf("found reverse removed item (%v, %v) does not match expected item (%v, %v)", item, err, 2, nil)
+	}
+	if item, err := list.ReverseRemoveFirst(isTwoOrFour); err != nil || item != 4 {
+		t.Errorf("found reverse removed item (%v, %v) does not match expected item (%v, %v)", item, err, 4, nil)
+	}
+	if !slices.Equal(list.Items(), []int{0, 1, 3, 5}) {
+		t.Errorf("found items (%v) does not match expected items (%v)", list.Items(), []int{0, 1, 3, 5})
+	}
+
+	if _, err := list.RemoveFirst(isTwoOrFour); !errors.Is(err, dsa_error.ErrorItemNotFound) {
+		t.Errorf("expected ErrorItemNotFound when no item matches, found (%v)", err)
+	}
+	if _, err := list.ReverseRemoveFirst(isTwoOrFour); !errors.Is(err, dsa_error.ErrorItemNotFound) {
+		t.Errorf("expected ErrorItemNotFound when no item matches, found (%v)", err)
+	}
+}
+
+func TestLinkedListRemoveAllRetain(t *testing.T) {
+	items := []int{0, 1, 2, 3, 4, 5, 6}
+	list := linkedlist.New[int]()
+	for _, item := range items {
+		list.Add(item)
+	}
+
+	// Each item is tested exactly once, and the remaining items keep their order
+	tested := make(map[int]int)
+	numRemoved := list.RemoveAll(func(item int) bool {
+		tested[item] += 1
+		return item%3 == 0
+	})
+	if numRemoved != 3 {
+		t.Errorf("found number removed (%v) does not match expected number removed (%v)", numRemoved, 3)
+	}
+	for _, item := range items {
+		if tested[item] != 1 {
+			t.Errorf("item (%v) tested (%v) times, expected once", item, tested[item])
+		}
+	}
+	if !slices.Equal(list.Items(), []int{1, 2, 4, 5}) {
+		t.Errorf("found items (%v) does not match expected items (%v)", list.Items(), []int{1, 2, 4, 5})
+	}
+
+	numRemoved = list.Retain(func(item int) bool { return item%2 == 0 })
+	if numRemoved != 2 || !slices.Equal(list.Items(), []int{2, 4}) || list.Length() != 2 {
+		t.Errorf("found items (%v) after retaining does not match expected items (%v)", list.Items(), []int{2, 4})
+	}
+	if list.RemoveAll(func(item int) bool { return true }) != 2 || list.Length() != 0 {
+		t.Errorf("expected removing every item to leave an empty list, found (%v)", list.Items())
+	}
+}
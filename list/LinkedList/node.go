@@ -4,6 +4,44 @@ type LinkedListNode[T any] struct {
 	item T
 	next *LinkedListNode[T]
 	prev *LinkedListNode[T]
+
+	// The owner of this node, resolving to the list this node belongs to.
+	// nil if the node has been removed from its list.
+	owner *nodeOwner[T]
+}
+
+// Identifies the list a node belongs to.
+//
+// Rather than pointing directly at their list, nodes point at an owner. When the nodes of one list
+// are moved into another in bulk (e.g. by Concat), the old owner is forwarded to the new list's owner,
+// so ownership of every moved node changes in O(1) without visiting the nodes.
+type nodeOwner[T any] struct {
+	// The list owning the nodes. Only meaningful when forward is nil.
+	list *LinkedList[T]
+
+	// The owner this owner has been merged into, or nil if this is the current owner of a list.
+	forward *nodeOwner[T]
+}
+
+// Get the list this node belongs to, or nil if the node has been removed from its list.
+//
+// Forwarded owners are compressed along the way, so repeated lookups are fast.
+func (node *LinkedListNode[T]) ownerList() *LinkedList[T] {
+	if node.owner == nil {
+		return nil
+	}
+
+	root := node.owner
+	for root.forward != nil {
+		root = root.forward
+	}
+	for owner := node.owner; owner != root; {
+		next := owner.forward
+		owner.forward = root
+		owner = next
+	}
+	node.owner = root
+	return root.list
 }
 
 // Get the item of this node.
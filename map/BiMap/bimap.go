@@ -0,0 +1,232 @@
+package bimap
+
+import (
+	"iter"
+
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
+)
+
+// An implementation of a bidirectional map, where both keys and values are unique.
+//
+// Each key maps to exactly one value and each value maps back to exactly one key,
+// so lookups are O(1) in both directions. Internally, the map holds one Go map in each direction,
+// and every update changes both maps together so they can never drift apart.
+//
+// Inverse returns a view of the same map with keys and values swapped. The view shares storage with this map,
+// so changes through either are visible in both.
+type BiMap[K comparable, V comparable] struct {
+	forward  map[K]V
+	backward map[V]K
+
+	// The inverse view of this map, sharing the same underlying maps with the directions swapped.
+	inverse *BiMap[V, K]
+}
+
+// Create a new, empty BiMap.
+func New[K comparable, V comparable]() *BiMap[K, V] {
+	forward := make(map[K]V)
+	backward := make(map[V]K)
+
+	biMap := &BiMap[K, V]{
+		forward:  forward,
+		backward: backward,
+	}
+	biMap.inverse = &BiMap[V, K]{
+		forward:  backward,
+		backward: forward,
+		inverse:  biMap,
+	}
+	return biMap
+}
+
+// ----------------------------------------------------------------------------
+// Get Methods
+
+// Return the size of the map, the number of key-value pairs contained.
+func (biMap *BiMap[K, V]) Size() int {
+	return len(biMap.forward)
+}
+
+// Get a view of this map with keys and values swapped.
+//
+// The view shares storage with this map: changes to the view are visible in this map, and vice versa.
+// The inverse of the inverse is this map.
+func (biMap *BiMap[K, V]) Inverse() *BiMap[V, K] {
+	return biMap.inverse
+}
+
+// Get the value associated with a key.
+//
+// Returns a dsa_error.ErrorItemNotFound if the key is not present in the map.
+func (biMap *BiMap[K, V]) GetByKey(key K) (V, error) {
+	value, ok := biMap.forward[key]
+	if !ok {
+		return *new(V), dsa_error.ErrorItemNotFound
+	}
+	return value, nil
+}
+
+// Get the key associated with a value.
+//
+// Returns a dsa_error.ErrorItemNotFound if the value is not present in the map.
+func (biMap *BiMap[K, V]) GetByValue(value V) (K, error) {
+	key, ok := biMap.backward[value]
+	if !ok {
+		return *new(K), dsa_error.ErrorItemNotFound
+	}
+	return key, nil
+}
+
+// Checks if a key is present in the map.
+func (biMap *BiMap[K, V]) ContainsKey(key K) bool {
+	_, ok := biMap.forward[key]
+	return ok
+}
+
+// Checks if a value is present in the map.
+func (biMap *BiMap[K, V]) ContainsValue(value V) bool {
+	_, ok := biMap.backward[value]
+	return ok
+}
+
+// Get all keys from the map. This method allocates an array of length equal to the number of pairs.
+// The keys are not guaranteed to be in the order they were inserted into the map.
+func (biMap *BiMap[K, V]) Keys() []K {
+	keys := make([]K, 0, len(biMap.forward))
+	for key := range biMap.forward {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// Get all values from the map. This method allocates an array of length equal to the number of pairs.
+// The values are not guaranteed to be in the order they were inserted into the map.
+func (biMap *BiMap[K, V]) Values() []V {
+	values := make([]V, 0, len(biMap.backward))
+	for value := range biMap.backward {
+		values = append(values, value)
+	}
+	return values
+}
+
+// ----------------------------------------------------------------------------
+// Add Methods
+
+// Associate a key with a value.
+//
+// Putting a pair that is already present does nothing.
+// Returns a dsa_error.ErrorItemAlreadyPresent (and does not change the map) if the key is already associated with a different value,
+// or if the value is already associated with a different key. To replace conflicting pairs, use ForcePut.
+func (biMap *BiMap[K, V]) Put(key K, value V) error {
+	existingValue, keyPresent := biMap.forward[key]
+	existingKey, valuePresent := biMap.backward[value]
+	if keyPresent && valuePresent && existingValue == value && existingKey == key {
+		return nil
+	}
+	if keyPresent || valuePresent {
+		return dsa_error.ErrorItemAlreadyPresent
+	}
+
+	biMap.forward[key] = value
+	biMap.backward[value] = key
+	return nil
+}
+
+// Associate a key with a value, removing any pair that conflicts with the new pair.
+//
+// Any value previously associated with the key, and any key previously associated with the value, are removed from the map.
+// Hence, this method may reduce the size of the map by one.
+func (biMap *BiMap[K, V]) ForcePut(key K, value V) {
+	if existingValue, ok := biMap.forward[key]; ok {
+		delete(biMap.backward, existingValue)
+	}
+	if existingKey, ok := biMap.backward[value]; ok {
+		delete(biMap.forward, existingKey)
+	}
+
+	biMap.forward[key] = value
+	biMap.backward[value] = key
+}
+
+// ----------------------------------------------------------------------------
+// Remove Methods
+
+// Remove a key and its associated value from the map, returning the value.
+//
+// Returns a dsa_error.ErrorItemNotFound if the key is not present in the map.
+func (biMap *BiMap[K, V]) DeleteByKey(key K) (V, error) {
+	value, ok := biMap.forward[key]
+	if !ok {
+		return *new(V), dsa_error.ErrorItemNotFound
+	}
+
+	delete(biMap.forward, key)
+	delete(biMap.backward, value)
+	return value, nil
+}
+
+// Remove a value and its associated key from the map, returning the key.
+//
+// Returns a dsa_error.ErrorItemNotFound if the value is not present in the map.
+func (biMap *BiMap[K, V]) DeleteByValue(value V) (K, error) {
+	return biMap.inverse.DeleteByKey(value)
+}
+
+// Remove all pairs from the map. The inverse view is cleared too.
+func (biMap *BiMap[K, V]) Clear() {
+	clear(biMap.forward)
+	clear(biMap.backward)
+}
+
+// ----------------------------------------------------------------------------
+// Apply and Fold methods
+//
+// Methods to apply a function across ALL pairs in a map.
+
+// Iterate over the pairs of the map and apply a function to each key and value.
+//
+// Idiomatic Go should likely use Iterator() rather than functional methods.
+//
+// BEWARE: Iteration over a map does not guarantee a specific order ---
+// you may find pairs in any order, not the order they were inserted!
+// Ensure your function accounts for this.
+//
+// To accumulate values over pairs, use Fold.
+func Apply[K comparable, V comparable](biMap *BiMap[K, V], f func(key K, value V)) {
+	for key, value := range biMap.forward {
+		f(key, value)
+	}
+}
+
+// Iterate over the pairs of the map and apply the function f.
+// The function f also takes the current value of the accumulator.
+// The results of f become the new value of the accumulator at each step.
+//
+// Idiomatic Go should likely use Iterator() rather than functional methods.
+//
+// BEWARE: Iteration over a map does not guarantee a specific order ---
+// you may find pairs in any order, not the order they were inserted!
+// Ensure your function accounts for this. This is especially important for
+// a fold!
+//
+// This function is not a method on BiMap to allow for generic accumulators.
+func Fold[K comparable, V comparable, G any](biMap *BiMap[K, V], initialAccumulator G, f func(key K, value V, accumulator G) G) G {
+	accumulator := initialAccumulator
+	for key, value := range biMap.forward {
+		accumulator = f(key, value, accumulator)
+	}
+
+	return accumulator
+}
+
+// Iterate over the keys and values of the map. Note the iteration order may not be the insertion order.
+// This method is not concurrency safe. For concurrent applications, consider using a mutex, or pull the data out using Keys() and Values().
+func (biMap *BiMap[K, V]) Iterator() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for key, value := range biMap.forward {
+			if !yield(key, value) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,203 @@
+package bimap_test
+
+import (
+	"errors"
+	"maps"
+	"math/rand/v2"
+	"testing"
+
+	bimap "github.com/hmcalister/Go-DSA/map/BiMap"
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
+)
+
+// a helper method to check the pairs of a BiMap, in both directions, against a Go map.
+//
+// calls t.Errorf if the map or its inverse does not contain exactly the expected pairs.
+func checkBiMapPairs(t *testing.T, biMap *bimap.BiMap[int, string], expectedPairs map[int]string) {
+	t.Helper()
+
+	pairs := maps.Collect(biMap.Iterator())
+	if !maps.Equal(pairs, expectedPairs) {
+		t.Errorf("found pairs (%v) does not match expected pairs (%v)", pairs, expectedPairs)
+	}
+	if biMap.Size() != len(expectedPairs) || biMap.Inverse().Size() != len(expectedPairs) {
+		t.Errorf("found sizes (%v, %v) do not match expected size (%v)", biMap.Size(), biMap.Inverse().Size(), len(expectedPairs))
+	}
+	for key, value := range expectedPairs {
+		if foundKey, err := biMap.GetByValue(value); err != nil || foundKey != key {
+			t.Errorf("found key (%v, %v) for value (%v) does not match expected key (%v, %v)", foundKey, err, value, key, nil)
+		}
+		if foundKey, err := biMap.Inverse().GetByKey(value); err != nil || foundKey != key {
+			t.Errorf("found inverse key (%v, %v) for value (%v) does not match expected key (%v, %v)", foundKey, err, value, key, nil)
+		}
+	}
+}
+
+func TestBiMapInit(t *testing.T) {
+	t.Run("int", func(t *testing.T) {
+		bimap.New[int, int]()
+	})
+	t.Run("float", func(t *testing.T) {
+		bimap.New[float64, int]()
+	})
+	t.Run("string", func(t *testing.T) {
+		bimap.New[string, int]()
+	})
+	t.Run("struct", func(t *testing.T) {
+		type S struct {
+			_ int
+			_ float64
+			_ string
+		}
+		bimap.New[S, string]()
+	})
+}
+
+func TestBiMapPutGet(t *testing.T) {
+	biMap := bimap.New[int, string]()
+
+	if err := biMap.Put(1, "one"); err != nil {
+		t.Errorf("error when putting new pair: %v", err)
+	}
+	if err := biMap.Put(2, "two"); err != nil {
+		t.Errorf("error when putting new pair: %v", err)
+	}
+	if err := biMap.Put(1, "one"); err != nil {
+		t.Errorf("error when putting existing pair: %v", err)
+	}
+
+	// Conflicts on either side are rejected, leaving the map unchanged
+	if err := biMap.Put(1, "uno"); !errors.Is(err, dsa_error.ErrorItemAlreadyPresent) {
+		t.Errorf("expected ErrorItemAlreadyPresent when putting conflicting key, found (%v)", err)
+	}
+	if err := biMap.Put(3, "two"); !errors.Is(err, dsa_error.ErrorItemAlreadyPresent) {
+		t.Errorf("expected ErrorItemAlreadyPresent when putting conflicting value, found (%v)", err)
+	}
+	if err := biMap.Put(1, "two"); !errors.Is(err, dsa_error.ErrorItemAlreadyPresent) {
+		t.Errorf("expected ErrorItemAlreadyPresent when putting pair conflicting on both sides, found (%v)", err)
+	}
+	checkBiMapPairs(t, biMap, map[int]string{1: "one", 2: "two"})
+
+	if _, err := biMap.GetByKey(3); !errors.Is(err, dsa_error.ErrorItemNotFound) {
+		t.Errorf("expected ErrorItemNotFound for missing key, found (%v)", err)
+	}
+	if _, err := biMap.GetByValue("three"); !errors.Is(err, dsa_error.ErrorItemNotFound) {
+		t.Errorf("expected ErrorItemNotFound for missing value, found (%v)", err)
+	}
+	if !biMap.ContainsKey(2) || !biMap.ContainsValue("two") || biMap.ContainsKey(3) || biMap.ContainsValue("three") {
+		t.Errorf("found unexpected result from ContainsKey or ContainsValue")
+	}
+}
+
+func TestBiMapForcePut(t *testing.T) {
+	biMap := bimap.New[int, string]()
+	biMap.Put(1, "one")
+	biMap.Put(2, "two")
+	biMap.Put(3, "three")
+
+	// Replaces the value of an existing key
+	biMap.ForcePut(1, "uno")
+	checkBiMapPairs(t, biMap, map[int]string{1: "uno", 2: "two", 3: "three"})
+
+	// Moves an existing value to a new key
+	biMap.ForcePut(4, "two")
+	checkBiMapPairs(t, biMap, map[int]string{1: "uno", 3: "three", 4: "two"})
+
+	// Conflicts on both sides remove two pairs and add one
+	biMap.ForcePut(1, "three")
+	checkBiMapPairs(t, biMap, map[int]string{1: "three", 4: "two"})
+}
+
+func TestBiMapDelete(t *testing.T) {
+	biMap := bimap.New[int, string]()
+	biMap.Put(1, "one")
+	biMap.Put(2, "two")
+	biMap.Put(3, "three")
+
+	if value, err := biMap.DeleteByKey(1); err != nil || value != "one" {
+		t.Errorf("found deleted value (%v, %v) does not match expected (%v, %v)", value, err, "one", nil)
+	}
+	if key, err := biMap.DeleteByValue("two"); err != nil || key != 2 {
+		t.Errorf("found deleted key (%v, %v) does not match expected (%v, %v)", key, err, 2, nil)
+	}
+	if _, err := biMap.DeleteByKey(1); !errors.Is(err, dsa_error.ErrorItemNotFound) {
+		t.Errorf("expected ErrorItemNotFound when deleting missing key, found (%v)", err)
+	}
+	if _, err := biMap.DeleteByValue("two"); !errors.Is(err, dsa_error.ErrorItemNotFound) {
+		t.Errorf("expected ErrorItemNotFound when deleting missing value, found (%v)", err)
+	}
+	checkBiMapPairs(t, biMap, map[int]string{3: "three"})
+
+	biMap.Clear()
+	checkBiMapPairs(t, biMap, map[int]string{})
+}
+
+func TestBiMapInverseSharesStorage(t *testing.T) {
+	biMap := bimap.New[int, string]()
+	inverse := biMap.Inverse()
+	if inverse.Inverse() != biMap {
+		t.Errorf("expected the inverse of the inverse to be the original map")
+	}
+
+	biMap.Put(1, "one")
+	inverse.Put("two", 2)
+	checkBiMapPairs(t, biMap, map[int]string{1: "one", 2: "two"})
+
+	inverse.DeleteByKey("one")
+	inverse.ForcePut("deux", 2)
+	checkBiMapPairs(t, biMap, map[int]string{2: "deux"})
+
+	inverse.Clear()
+	checkBiMapPairs(t, biMap, map[int]string{})
+}
+
+func TestBiMapRandomOperations(t *testing.T) {
+	randomGenerator := rand.New(rand.NewPCG(0, 0))
+	biMap := bimap.New[int, string]()
+	values := []string{"a", "b", "c", "d", "e", "f", "g", "h"}
+
+	for step := range 2000 {
+		key := randomGenerator.IntN(10)
+		value := values[randomGenerator.IntN(len(values))]
+		switch randomGenerator.IntN(4) {
+		case 0:
+			biMap.Put(key, value)
+		case 1:
+			biMap.ForcePut(key, value)
+		case 2:
+			biMap.DeleteByKey(key)
+		case 3:
+			biMap.Inverse().DeleteByKey(value)
+		}
+
+		// Both directions must always agree
+		for key, value := range biMap.Iterator() {
+			if foundKey, err := biMap.GetByValue(value); err != nil || foundKey != key {
+				t.Fatalf("step %v: found key (%v, %v) for value (%v) does not match expected key (%v)", step, foundKey, err, value, key)
+			}
+		}
+		if biMap.Size() != biMap.Inverse().Size() {
+			t.Fatalf("step %v: found size (%v) does not match inverse size (%v)", step, biMap.Size(), biMap.Inverse().Size())
+		}
+	}
+}
+
+func TestBiMapApplyFold(t *testing.T) {
+	biMap := bimap.New[int, int]()
+	for key := 1; key <= 10; key += 1 {
+		biMap.Put(key, -key)
+	}
+
+	sum := 0
+	bimap.Apply(biMap, func(key int, value int) { sum += key - value })
+	if sum != 110 {
+		t.Errorf("result (%v) does not match expected result (%v)", sum, 110)
+	}
+
+	foldedSum := bimap.Fold(biMap.Inverse(), 0, func(key int, value int, accumulator int) int {
+		return accumulator + key
+	})
+	if foldedSum != -55 {
+		t.Errorf("result (%v) does not match expected result (%v)", foldedSum, -55)
+	}
+}
@@ -0,0 +1,311 @@
+package concurrenthashmap
+
+import (
+	"iter"
+	"math/bits"
+	"sync"
+
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
+	hasher "github.com/hmcalister/Go-DSA/utils/Hasher"
+)
+
+// The assumed size of a CPU cache line, used to pad shards
+// so that goroutines locking neighbouring shards do not contend on the same cache line (false sharing).
+const cacheLineSize = 64
+
+// A segment of the map, holding the entries whose keys hash to it, with its own lock.
+type shard[K comparable, V any] struct {
+	mutex     sync.RWMutex
+	shardData map[K]V
+	_         [cacheLineSize]byte
+}
+
+// Implement a hash map safe for concurrent use, split by hash into independently locked shards.
+//
+// A single mutex around a Go map serialises every goroutine using the map. Here each key is assigned to one shard by its hash,
+// and each shard is a Go map with its own read-write lock, so goroutines using keys of different shards never block one another.
+// With many more shards than goroutines, contention is rare.
+//
+// Operations on a single key (Get, Put, LoadOrStore, Compute, Remove) are atomic.
+// Operations over the whole map (Size, Keys, Iterator) visit the shards one at a time, so are not atomic.
+//
+// A ConcurrentHashMap is safe for concurrent use by any number of goroutines.
+type ConcurrentHashMap[K comparable, V any] struct {
+	// The shards of the map, with length a power of two
+	shards []shard[K, V]
+
+	// len(shards)-1, so that hash&mask is the index of the shard of a key
+	mask uint64
+
+	hashFunction hasher.HashFunction[K]
+}
+
+// Create a new ConcurrentHashMap with at least the given number of shards.
+// The hash function assigns keys to shards, and must give equal hashes for equal keys.
+//
+// The number of shards is rounded up to the next power of two (and is at least 1), which can be checked with ShardCount().
+// A few times the number of goroutines expected to use the map concurrently is a reasonable choice.
+func New[K comparable, V any](numShards int, hashFunction hasher.HashFunction[K]) *ConcurrentHashMap[K, V] {
+	roundedNumShards := uint64(1) << bits.Len(uint(max(1, numShards)-1))
+
+	hashMap := &ConcurrentHashMap[K, V]{
+		shards:       make([]shard[K, V], roundedNumShards),
+		mask:         roundedNumShards - 1,
+		hashFunction: hashFunction,
+	}
+	for index := range hashMap.shards {
+		hashMap.shards[index].shardData = make(map[K]V)
+	}
+	return hashMap
+}
+
+// ----------------------------------------------------------------------------
+// Helper Methods
+
+// Mix the bits of the user supplied hash, so that poor hash functions (such as the identity on integers) still spread over the shards.
+//
+// This is the finalizer of MurmurHash3, as used by github.com/hmcalister/Go-DSA/map/CustomHashMap.
+func mixHash(hash uint64) uint64 {
+	hash ^= hash >> 33
+	hash *= 0xff51afd7ed558ccd
+	hash ^= hash >> 33
+	hash *= 0xc4ceb9fe1a85ec53
+	hash ^= hash >> 33
+	return hash
+}
+
+// Get the shard a key is assigned to.
+func (hashMap *ConcurrentHashMap[K, V]) shardOf(key K) *shard[K, V] {
+	return &hashMap.shards[mixHash(hashMap.hashFunction(key))&hashMap.mask]
+}
+
+// ----------------------------------------------------------------------------
+// Get Methods
+
+// Get the number of shards of the map.
+func (hashMap *ConcurrentHashMap[K, V]) ShardCount() int {
+	return len(hashMap.shards)
+}
+
+// Get the size of the map, the number of entries contained.
+//
+// The shards are counted one at a time, so if other goroutines modify the map concurrently
+// the size is only approximate, and may be out of date as soon as it is returned.
+func (hashMap *ConcurrentHashMap[K, V]) Size() int {
+	size := 0
+	for index := range hashMap.shards {
+		currentShard := &hashMap.shards[index]
+		currentShard.mutex.RLock()
+		size += len(currentShard.shardData)
+		currentShard.mutex.RUnlock()
+	}
+	return size
+}
+
+// Get the value associated with a key.
+//
+// Returns a dsa_error.ErrorItemNotFound if the key is not present in the map.
+func (hashMap *ConcurrentHashMap[K, V]) Get(key K) (V, error) {
+	currentShard := hashMap.shardOf(key)
+	currentShard.mutex.RLock()
+	defer currentShard.mutex.RUnlock()
+
+	value, ok := currentShard.shardData[key]
+	if !ok {
+		return *new(V), dsa_error.ErrorItemNotFound
+	}
+	return value, nil
+}
+
+// Checks if a key is present in the map.
+func (hashMap *ConcurrentHashMap[K, V]) ContainsKey(key K) bool {
+	currentShard := hashMap.shardOf(key)
+	currentShard.mutex.RLock()
+	defer currentShard.mutex.RUnlock()
+
+	_, ok := currentShard.shardData[key]
+	return ok
+}
+
+// Get all keys from the map. The keys are in no particular order.
+//
+// The shards are visited one at a time, as described in Iterator().
+func (hashMap *ConcurrentHashMap[K, V]) Keys() []K {
+	keys := make([]K, 0)
+	for key := range hashMap.Iterator() {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// ----------------------------------------------------------------------------
+// Add Methods
+
+// Associate a value with a key, replacing any value already associated with the key.
+//
+// Returns (previousValue, true) if a value was replaced, or (*new(V), false) if the key was *not* already present.
+func (hashMap *ConcurrentHashMap[K, V]) Put(key K, value V) (V, bool) {
+	currentShard := hashMap.shardOf(key)
+	currentShard.mutex.Lock()
+	defer currentShard.mutex.Unlock()
+
+	previousValue, ok := currentShard.shardData[key]
+	currentShard.shardData[key] = value
+	return previousValue, ok
+}
+
+// Get the value associated with a key if present, otherwise associate the given value with the key, as one atomic operation.
+//
+// Returns (existingValue, true) if the key was already present, in which case the map is not changed,
+// or (value, false) if the given value was stored.
+func (hashMap *ConcurrentHashMap[K, V]) LoadOrStore(key K, value V) (V, bool) {
+	currentShard := hashMap.shardOf(key)
+
+	// Most calls for a key find it present, so first check under the cheaper read lock
+	currentShard.mutex.RLock()
+	existingValue, ok := currentShard.shardData[key]
+	currentShard.mutex.RUnlock()
+	if ok {
+		return existingValue, true
+	}
+
+	currentShard.mutex.Lock()
+	defer currentShard.mutex.Unlock()
+	if existingValue, ok := currentShard.shardData[key]; ok {
+		return existingValue, true
+	}
+	currentShard.shardData[key] = value
+	return value, false
+}
+
+// Update the entry of a key as one atomic operation.
+//
+// The function f is given the current value of the key and whether the key is present (if not, the value is *new(V)).
+// f returns the new value, and whether the key should be present afterwards: if false, the key is removed.
+//
+// Returns the value of the key after the update, and whether the key is present after the update.
+//
+// f is called while holding the lock of the shard of the key, so f should be quick, and must not use this map,
+// otherwise it may deadlock.
+func (hashMap *ConcurrentHashMap[K, V]) Compute(key K, f func(value V, present bool) (V, bool)) (V, bool) {
+	currentShard := hashMap.shardOf(key)
+	currentShard.mutex.Lock()
+	defer currentShard.mutex.Unlock()
+
+	value, present := currentShard.shardData[key]
+	newValue, keep := f(value, present)
+	if !keep {
+		delete(currentShard.shardData, key)
+		return *new(V), false
+	}
+	currentShard.shardData[key] = newValue
+	return newValue, true
+}
+
+// ----------------------------------------------------------------------------
+// Remove Methods
+
+// Remove a key (and its associated value) from the map, returning the value.
+//
+// Returns a dsa_error.ErrorItemNotFound if the key is not present in the map.
+func (hashMap *ConcurrentHashMap[K, V]) Remove(key K) (V, error) {
+	currentShard := hashMap.shardOf(key)
+	currentShard.mutex.Lock()
+	defer currentShard.mutex.Unlock()
+
+	value, ok := currentShard.shardData[key]
+	if !ok {
+		return *new(V), dsa_error.ErrorItemNotFound
+	}
+	delete(currentShard.shardData, key)
+	return value, nil
+}
+
+// Remove all entries from the map.
+//
+// The shards are cleared one at a time, so entries added concurrently may remain.
+func (hashMap *ConcurrentHashMap[K, V]) Clear() {
+	for index := range hashMap.shards {
+		currentShard := &hashMap.shards[index]
+		currentShard.mutex.Lock()
+		currentShard.shardData = make(map[K]V)
+		currentShard.mutex.Unlock()
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Apply, Fold, and Iterator methods
+
+// Iterate over the entries of the map and apply a function to each key and value.
+//
+// Idiomatic Go should likely use Iterator() rather than functional methods.
+//
+// BEWARE: Iteration over a hash map does not guarantee a specific order ---
+// you may find entries in any order, not the order they were inserted!
+// Ensure your function accounts for this.
+//
+// The shards are visited one at a time, as described in Iterator().
+//
+// To accumulate values over entries, use Fold.
+func Apply[K comparable, V any](hashMap *ConcurrentHashMap[K, V], f func(key K, value V)) {
+	for key, value := range hashMap.Iterator() {
+		f(key, value)
+	}
+}
+
+// Iterate over the entries of the map and apply the function f.
+// The function f also takes the current value of the accumulator.
+// The results of f become the new value of the accumulator at each step.
+//
+// Idiomatic Go should likely use Iterator() rather than functional methods.
+//
+// BEWARE: Iteration over a hash map does not guarantee a specific order ---
+// you may find entries in any order, not the order they were inserted!
+// Ensure your function accounts for this. This is especially important for
+// a fold!
+//
+// The shards are visited one at a time, as described in Iterator().
+//
+// This function is not a method on ConcurrentHashMap to allow for generic accumulators.
+func Fold[K comparable, V any, G any](hashMap *ConcurrentHashMap[K, V], initialAccumulator G, f func(key K, value V, accumulator G) G) G {
+	accumulator := initialAccumulator
+	for key, value := range hashMap.Iterator() {
+		accumulator = f(key, value, accumulator)
+	}
+
+	return accumulator
+}
+
+// Iterate over the entries of the map. Note the iteration order may not be the insertion order.
+//
+// This method is safe to use while other goroutines modify the map, with the following guarantees.
+// The shards are visited one at a time, and the entries of each shard are copied under its lock, then yielded with no lock held.
+// Hence each key is yielded at most once, and every entry present for the whole iteration is yielded.
+// Entries added, removed, or updated concurrently may or may not be seen, and no single instant of the whole map is captured.
+//
+// Since no lock is held while yielding, the body of the loop may itself use the map.
+func (hashMap *ConcurrentHashMap[K, V]) Iterator() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		type shardEntry struct {
+			key   K
+			value V
+		}
+
+		var entries []shardEntry
+		for index := range hashMap.shards {
+			currentShard := &hashMap.shards[index]
+			currentShard.mutex.RLock()
+			entries = entries[:0]
+			for key, value := range currentShard.shardData {
+				entries = append(entries, shardEntry{key, value})
+			}
+			currentShard.mutex.RUnlock()
+
+			for _, currentEntry := range entries {
+				if !yield(currentEntry.key, currentEntry.value) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,282 @@
+package concurrenthashmap_test
+
+import (
+	"errors"
+	"maps"
+	"sync"
+	"testing"
+
+	concurrenthashmap "github.com/hmcalister/Go-DSA/map/ConcurrentHashMap"
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
+	hasher "github.com/hmcalister/Go-DSA/utils/Hasher"
+)
+
+// a helper method to check the entries of a map against a Go map.
+//
+// calls t.Errorf if the map does not contain exactly the expected entries.
+func checkConcurrentHashMapEntries(t *testing.T, hashMap *concurrenthashmap.ConcurrentHashMap[int, int], expectedEntries map[int]int) {
+	t.Helper()
+
+	entries := maps.Collect(hashMap.Iterator())
+	if !maps.Equal(entries, expectedEntries) {
+		t.Errorf("found entries (%v) does not match expected entries (%v)", entries, expectedEntries)
+	}
+	if hashMap.Size() != len(expectedEntries) {
+		t.Errorf("found size (%v) does not match expected size (%v)", hashMap.Size(), len(expectedEntries))
+	}
+	for key, expectedValue := range expectedEntries {
+		if value, err := hashMap.Get(key); err != nil || value != expectedValue {
+			t.Errorf("found value (%v, %v) of key (%v) does not match expected value (%v, %v)", value, err, key, expectedValue, nil)
+		}
+	}
+}
+
+func TestConcurrentHashMapInit(t *testing.T) {
+	t.Run("int", func(t *testing.T) {
+		concurrenthashmap.New[int, int](16, hasher.DefaultIntegerHashFunction)
+	})
+	t.Run("float", func(t *testing.T) {
+		concurrenthashmap.New[float64, int](16, hasher.DefaultFloat64HashFunction)
+	})
+	t.Run("string", func(t *testing.T) {
+		concurrenthashmap.New[string, int](16, hasher.DefaultStringHashFunction)
+	})
+	t.Run("struct", func(t *testing.T) {
+		type S struct {
+			i int
+			_ float64
+			_ string
+		}
+		concurrenthashmap.New[S, int](16, func(item S) uint64 { return uint64(item.i) })
+	})
+	t.Run("shard count", func(t *testing.T) {
+		for _, testCase := range []struct{ numShards, expectedShardCount int }{{0, 1}, {1, 1}, {5, 8}, {64, 64}} {
+			hashMap := concurrenthashmap.New[int, int](testCase.numShards, hasher.DefaultIntegerHashFunction)
+			if hashMap.ShardCount() != testCase.expectedShardCount {
+				t.Errorf("found shard count (%v) does not match expected shard count (%v)", hashMap.ShardCount(), testCase.expectedShardCount)
+			}
+		}
+	})
+}
+
+func TestConcurrentHashMapPutGetRemove(t *testing.T) {
+	hashMap := concurrenthashmap.New[int, int](4, hasher.DefaultIntegerHashFunction)
+	expectedEntries := make(map[int]int)
+	for key := range 100 {
+		if _, replaced := hashMap.Put(key, key*key); replaced {
+			t.Errorf("expected putting new key (%v) to not replace a value", key)
+		}
+		expectedEntries[key] = key * key
+	}
+	if previousValue, replaced := hashMap.Put(3, -3); !replaced || previousValue != 9 {
+		t.Errorf("found replaced value (%v, %v) does not match expected value (%v, %v)", previousValue, replaced, 9, true)
+	}
+	expectedEntries[3] = -3
+	checkConcurrentHashMapEntries(t, hashMap, expectedEntries)
+
+	if value, err := hashMap.Remove(3); err != nil || value != -3 {
+		t.Errorf("found removed value (%v, %v) does not match expected value (%v, %v)", value, err, -3, nil)
+	}
+	delete(expectedEntries, 3)
+	if _, err := hashMap.Remove(3); !errors.Is(err, dsa_error.ErrorItemNotFound) {
+		t.Errorf("expected ErrorItemNotFound when removing missing key, found (%v)", err)
+	}
+	if _, err := hashMap.Get(3); !errors.Is(err, dsa_error.ErrorItemNotFound) {
+		t.Errorf("expected ErrorItemNotFound when getting missing key, found (%v)", err)
+	}
+	if hashMap.ContainsKey(3) || !hashMap.ContainsKey(4) {
+		t.Errorf("found unexpected result from ContainsKey")
+	}
+	checkConcurrentHashMapEntries(t, hashMap, expectedEntries)
+	if keys := hashMap.Keys(); len(keys) != len(expectedEntries) {
+		t.Errorf("found number of keys (%v) does not match expected number (%v)", len(keys), len(expectedEntries))
+	}
+
+	hashMap.Clear()
+	checkConcurrentHashMapEntries(t, hashMap, map[int]int{})
+}
+
+func TestConcurrentHashMapLoadOrStore(t *testing.T) {
+	hashMap := concurrenthashmap.New[int, int](4, hasher.DefaultIntegerHashFunction)
+	if value, loaded := hashMap.LoadOrStore(1, 10); loaded || value != 10 {
+		t.Errorf("found stored value (%v, %v) does not match expected value (%v, %v)", value, loaded, 10, false)
+	}
+	if value, loaded := hashMap.LoadOrStore(1, 11); !loaded || value != 10 {
+		t.Errorf("found loaded value (%v, %v) does not match expected value (%v, %v)", value, loaded, 10, true)
+	}
+	checkConcurrentHashMapEntries(t, hashMap, map[int]int{1: 10})
+}
+
+func TestConcurrentHashMapCompute(t *testing.T) {
+	hashMap := concurrenthashmap.New[int, int](4, hasher.DefaultIntegerHashFunction)
+	increment := func(value int, present bool) (int, bool) { return value + 1, true }
+
+	if value, present := hashMap.Compute(1, increment); !present || value != 1 {
+		t.Errorf("found computed value (%v, %v) does not match expected value (%v, %v)", value, present, 1, true)
+	}
+	hashMap.Compute(1, increment)
+	checkConcurrentHashMapEntries(t, hashMap, map[int]int{1: 2})
+
+	// Returning false removes the key, or leaves a missing key missing
+	removeEven := func(value int, present bool) (int, bool) { return value, present && value%2 == 1 }
+	if value, present := hashMap.Compute(1, removeEven); present || value != 0 {
+		t.Errorf("found computed value (%v, %v) does not match expected value (%v, %v)", value, present, 0, false)
+	}
+	hashMap.Compute(2, removeEven)
+	checkConcurrentHashMapEntries(t, hashMap, map[int]int{})
+}
+
+func TestConcurrentHashMapApplyFold(t *testing.T) {
+	hashMap := concurrenthashmap.New[int, int](4, hasher.DefaultIntegerHashFunction)
+	for key := 1; key <= 10; key += 1 {
+		hashMap.Put(key, -key)
+	}
+
+	sum := 0
+	concurrenthashmap.Apply(hashMap, func(key int, value int) { sum += key - value })
+	if sum != 110 {
+		t.Errorf("result (%v) does not match expected result (%v)", sum, 110)
+	}
+
+	foldedSum := concurrenthashmap.Fold(hashMap, 0, func(key int, value int, accumulator int) int {
+		return accumulator + value
+	})
+	if foldedSum != -55 {
+		t.Errorf("result (%v) does not match expected result (%v)", foldedSum, -55)
+	}
+}
+
+func TestConcurrentHashMapIteratorModify(t *testing.T) {
+	hashMap := concurrenthashmap.New[int, int](4, hasher.DefaultIntegerHashFunction)
+	for key := range 100 {
+		hashMap.Put(key, key)
+	}
+
+	// No lock is held while yielding, so the loop body may modify the map
+	for key := range hashMap.Iterator() {
+		hashMap.Remove(key)
+	}
+	checkConcurrentHashMapEntries(t, hashMap, map[int]int{})
+}
+
+// Run with -race to check the locking of the map.
+func TestConcurrentHashMapConcurrentCompute(t *testing.T) {
+	const numGoroutines = 16
+	incrementsPerGoroutine := 2000
+	if testing.Short() {
+		incrementsPerGoroutine = 200
+	}
+	numKeys := 64
+
+	hashMap := concurrenthashmap.New[int, int](8, hasher.DefaultIntegerHashFunction)
+	var waitGroup sync.WaitGroup
+	for goroutine := range numGoroutines {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			for step := range incrementsPerGoroutine {
+				key := (goroutine + step) % numKeys
+				hashMap.Compute(key, func(value int, present bool) (int, bool) { return value + 1, true })
+				hashMap.Get(key)
+			}
+		}()
+	}
+	waitGroup.Wait()
+
+	// No increment may be lost
+	total := concurrenthashmap.Fold(hashMap, 0, func(key int, value int, accumulator int) int { return accumulator + value })
+	if total != numGoroutines*incrementsPerGoroutine {
+		t.Errorf("found total (%v) does not match expected total (%v)", total, numGoroutines*incrementsPerGoroutine)
+	}
+	if hashMap.Size() != numKeys {
+		t.Errorf("found size (%v) does not match expected size (%v)", hashMap.Size(), numKeys)
+	}
+}
+
+// Run with -race to check the locking of the map.
+func TestConcurrentHashMapConcurrentLoadOrStore(t *testing.T) {
+	const numGoroutines = 16
+	numKeys := 1000
+
+	hashMap := concurrenthashmap.New[int, int](8, hasher.DefaultIntegerHashFunction)
+	storedCounts := make([]int, numGoroutines)
+	var waitGroup sync.WaitGroup
+	for goroutine := range numGoroutines {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			for key := range numKeys {
+				value, loaded := hashMap.LoadOrStore(key, goroutine)
+				if !loaded {
+					storedCounts[goroutine] += 1
+				}
+				if storedValue, _ := hashMap.Get(key); storedValue != value {
+					t.Errorf("found value (%v) of key (%v) does not match loaded value (%v)", storedValue, key, value)
+				}
+			}
+		}()
+	}
+	waitGroup.Wait()
+
+	// Each key is stored by exactly one goroutine
+	totalStored := 0
+	for _, storedCount := range storedCounts {
+		totalStored += storedCount
+	}
+	if totalStored != numKeys {
+		t.Errorf("found number of stored keys (%v) does not match expected number (%v)", totalStored, numKeys)
+	}
+}
+
+// Run with -race to check the locking of the map.
+//
+// Keys present for the whole iteration must be yielded exactly once, even while other keys are added and removed.
+func TestConcurrentHashMapConcurrentIterator(t *testing.T) {
+	numStableKeys := 500
+	hashMap := concurrenthashmap.New[int, int](8, hasher.DefaultIntegerHashFunction)
+	for key := range numStableKeys {
+		hashMap.Put(key, key)
+	}
+
+	done := make(chan struct{})
+	var waitGroup sync.WaitGroup
+	for goroutine := range 4 {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			key := numStableKeys + goroutine
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				hashMap.Put(key, key)
+				hashMap.Remove(key)
+				key += 4
+			}
+		}()
+	}
+
+	for range 20 {
+		seenCounts := make(map[int]int)
+		for key, value := range hashMap.Iterator() {
+			if key != value {
+				t.Errorf("found value (%v) of key (%v) does not match expected value (%v)", value, key, key)
+			}
+			seenCounts[key] += 1
+		}
+		for key := range numStableKeys {
+			if seenCounts[key] != 1 {
+				t.Errorf("found stable key (%v) yielded (%v) times, expected once", key, seenCounts[key])
+			}
+		}
+		for key, seenCount := range seenCounts {
+			if seenCount != 1 {
+				t.Errorf("found key (%v) yielded (%v) times, expected at most once", key, seenCount)
+			}
+		}
+	}
+	close(done)
+	waitGroup.Wait()
+}
@@ -0,0 +1,315 @@
+package customhashmap
+
+import (
+	"iter"
+
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
+	hasher "github.com/hmcalister/Go-DSA/utils/Hasher"
+)
+
+// The smallest capacity of the table, once any entry has been added.
+// The table is never shrunk below this capacity. Must be a power of two.
+const minimumCapacity = 8
+
+// A slot of the hash table.
+type slot[K any, V any] struct {
+	key   K
+	value V
+
+	// The mixed hash of the key, stored to avoid recomputing it when probing or resizing.
+	hash uint64
+
+	// One more than the distance of this slot from the home slot of the key (hash & mask).
+	// Zero means the slot is empty.
+	probeLength uint32
+}
+
+// Implement a hash map for keys that are not comparable, or that need custom equality,
+// using a user supplied hash function and equality function.
+//
+// Go maps require comparable keys, so cannot hold slices (or structs containing slices),
+// nor keys with custom equality such as case insensitive strings. CustomHashMap can.
+//
+// The map is an open addressing hash table using Robin Hood hashing: when inserting, an entry far from its home slot
+// takes the place of an entry closer to its own home slot. This keeps probe lengths short and uniform,
+// allowing a high load factor. Removal uses backward shift deletion, so no tombstones are needed.
+//
+// The table doubles in capacity when more than 80% full, and halves when less than 10% full.
+type CustomHashMap[K any, V any] struct {
+	// The table of slots. The length of this slice is always zero or a power of two.
+	mapData []slot[K, V]
+
+	// The number of entries in the map.
+	size int
+
+	hashFunction     hasher.HashFunction[K]
+	equalityFunction hasher.EqualityFunction[K]
+}
+
+// Create a new CustomHashMap.
+//
+// The hash function and equality function must agree: keys that are equal must have equal hashes.
+func New[K any, V any](hashFunction hasher.HashFunction[K], equalityFunction hasher.EqualityFunction[K]) *CustomHashMap[K, V] {
+	return &CustomHashMap[K, V]{
+		// The table is only allocated once an entry is added.
+		mapData:          make([]slot[K, V], 0),
+		size:             0,
+		hashFunction:     hashFunction,
+		equalityFunction: equalityFunction,
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Hash Table Helper Methods
+
+// Mix the bits of the user supplied hash, so that poor hash functions (such as the identity on integers) still spread over the table.
+//
+// This is the finalizer of MurmurHash3.
+func mixHash(hash uint64) uint64 {
+	hash ^= hash >> 33
+	hash *= 0xff51afd7ed558ccd
+	hash ^= hash >> 33
+	hash *= 0xc4ceb9fe1a85ec53
+	hash ^= hash >> 33
+	return hash
+}
+
+// Find the index of the slot holding the given key, or -1 if the key is not present.
+func (hashMap *CustomHashMap[K, V]) findIndex(key K, hash uint64) int {
+	if hashMap.size == 0 {
+		return -1
+	}
+
+	mask := uint64(len(hashMap.mapData) - 1)
+	index := hash & mask
+	for probeLength := uint32(1); ; probeLength += 1 {
+		currentSlot := &hashMap.mapData[index]
+
+		// Under Robin Hood hashing, the key would have displaced any slot closer to its home than the key would be.
+		// Hence, reaching an empty slot or a slot with a shorter probe length means the key is not present.
+		if currentSlot.probeLength < probeLength {
+			return -1
+		}
+		if currentSlot.hash == hash && hashMap.equalityFunction(currentSlot.key, key) {
+			return int(index)
+		}
+		index = (index + 1) & mask
+	}
+}
+
+// Insert an entry known not to be present in the map. The table must have a free slot.
+func (hashMap *CustomHashMap[K, V]) insertNew(key K, value V, hash uint64) {
+	mask := uint64(len(hashMap.mapData) - 1)
+	index := hash & mask
+	inserting := slot[K, V]{
+		key:         key,
+		value:       value,
+		hash:        hash,
+		probeLength: 1,
+	}
+
+	for {
+		currentSlot := &hashMap.mapData[index]
+		if currentSlot.probeLength == 0 {
+			*currentSlot = inserting
+			return
+		}
+
+		// Take from the rich: the entry further from home takes the slot, and we continue inserting the displaced entry
+		if currentSlot.probeLength < inserting.probeLength {
+			*currentSlot, inserting = inserting, *currentSlot
+		}
+
+		index = (index + 1) & mask
+		inserting.probeLength += 1
+	}
+}
+
+// Remove the entry at the given index, shifting following displaced entries back towards their home slots.
+func (hashMap *CustomHashMap[K, V]) removeIndex(index int) {
+	mask := len(hashMap.mapData) - 1
+	for {
+		nextIndex := (index + 1) & mask
+		nextSlot := hashMap.mapData[nextIndex]
+
+		// Stop at an empty slot, or an entry already in its home slot
+		if nextSlot.probeLength <= 1 {
+			// Zero the slot so the removed key and value are not kept reachable
+			hashMap.mapData[index] = slot[K, V]{}
+			return
+		}
+
+		nextSlot.probeLength -= 1
+		hashMap.mapData[index] = nextSlot
+		index = nextIndex
+	}
+}
+
+// Move all entries into a new table of the given capacity.
+func (hashMap *CustomHashMap[K, V]) resize(capacity int) {
+	oldMapData := hashMap.mapData
+	hashMap.mapData = make([]slot[K, V], capacity)
+	for _, oldSlot := range oldMapData {
+		if oldSlot.probeLength != 0 {
+			hashMap.insertNew(oldSlot.key, oldSlot.value, oldSlot.hash)
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Get Methods
+
+// Return the size of the map, the number of entries contained.
+func (hashMap *CustomHashMap[K, V]) Size() int {
+	return hashMap.size
+}
+
+// Get the value associated with a key.
+//
+// Returns a dsa_error.ErrorItemNotFound if the key is not present in the map.
+func (hashMap *CustomHashMap[K, V]) Get(key K) (V, error) {
+	index := hashMap.findIndex(key, mixHash(hashMap.hashFunction(key)))
+	if index == -1 {
+		return *new(V), dsa_error.ErrorItemNotFound
+	}
+
+	return hashMap.mapData[index].value, nil
+}
+
+// Checks if a key is present in the map.
+func (hashMap *CustomHashMap[K, V]) ContainsKey(key K) bool {
+	return hashMap.findIndex(key, mixHash(hashMap.hashFunction(key))) != -1
+}
+
+// Get all keys from the map. This method allocates an array of length equal to the number of entries.
+// The keys are not guaranteed to be in the order they were inserted into the map.
+func (hashMap *CustomHashMap[K, V]) Keys() []K {
+	keys := make([]K, 0, hashMap.size)
+	for key := range hashMap.Iterator() {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// Get all values from the map. This method allocates an array of length equal to the number of entries.
+// The values are in the same order as the keys returned by Keys(), provided the map is not modified in between.
+func (hashMap *CustomHashMap[K, V]) Values() []V {
+	values := make([]V, 0, hashMap.size)
+	for _, value := range hashMap.Iterator() {
+		values = append(values, value)
+	}
+	return values
+}
+
+// ----------------------------------------------------------------------------
+// Add Methods
+
+// Associate a value with a key, replacing any value already associated with an equal key.
+// The key already in the map is kept.
+//
+// Returns (previousValue, true) if a value was replaced, or (*new(V), false) if the key was *not* already present.
+func (hashMap *CustomHashMap[K, V]) Put(key K, value V) (V, bool) {
+	hash := mixHash(hashMap.hashFunction(key))
+	if index := hashMap.findIndex(key, hash); index != -1 {
+		previousValue := hashMap.mapData[index].value
+		hashMap.mapData[index].value = value
+		return previousValue, true
+	}
+
+	// Grow when the load factor would exceed 0.8
+	if 5*(hashMap.size+1) > 4*len(hashMap.mapData) {
+		hashMap.resize(max(minimumCapacity, 2*len(hashMap.mapData)))
+	}
+
+	hashMap.insertNew(key, value, hash)
+	hashMap.size += 1
+	return *new(V), false
+}
+
+// ----------------------------------------------------------------------------
+// Remove Methods
+
+// Remove a key (and its associated value) from the map, returning the value.
+//
+// Returns a dsa_error.ErrorItemNotFound if the key is not present in the map.
+func (hashMap *CustomHashMap[K, V]) Remove(key K) (V, error) {
+	index := hashMap.findIndex(key, mixHash(hashMap.hashFunction(key)))
+	if index == -1 {
+		return *new(V), dsa_error.ErrorItemNotFound
+	}
+
+	value := hashMap.mapData[index].value
+	hashMap.removeIndex(index)
+	hashMap.size -= 1
+
+	// Shrink when the load factor falls below 0.1.
+	// Shrinking well below the growth threshold avoids repeatedly resizing when the size oscillates around a boundary.
+	if len(hashMap.mapData) > minimumCapacity && 10*hashMap.size < len(hashMap.mapData) {
+		hashMap.resize(len(hashMap.mapData) / 2)
+	}
+
+	return value, nil
+}
+
+// Remove all entries from the map.
+func (hashMap *CustomHashMap[K, V]) Clear() {
+	hashMap.mapData = make([]slot[K, V], 0)
+	hashMap.size = 0
+}
+
+// ----------------------------------------------------------------------------
+// Apply and Fold methods
+//
+// Methods to apply a function across ALL entries in a map.
+
+// Iterate over the entries of the map and apply a function to each key and value.
+//
+// Idiomatic Go should likely use Iterator() rather than functional methods.
+//
+// BEWARE: Iteration over a hash map does not guarantee a specific order ---
+// you may find entries in any order, not the order they were inserted!
+// Ensure your function accounts for this.
+//
+// To accumulate values over entries, use Fold.
+func Apply[K any, V any](hashMap *CustomHashMap[K, V], f func(key K, value V)) {
+	for key, value := range hashMap.Iterator() {
+		f(key, value)
+	}
+}
+
+// Iterate over the entries of the map and apply the function f.
+// The function f also takes the current value of the accumulator.
+// The results of f become the new value of the accumulator at each step.
+//
+// Idiomatic Go should likely use Iterator() rather than functional methods.
+//
+// BEWARE: Iteration over a hash map does not guarantee a specific order ---
+// you may find entries in any order, not the order they were inserted!
+// Ensure your function accounts for this. This is especially important for
+// a fold!
+//
+// This function is not a method on CustomHashMap to allow for generic accumulators.
+func Fold[K any, V any, G any](hashMap *CustomHashMap[K, V], initialAccumulator G, f func(key K, value V, accumulator G) G) G {
+	accumulator := initialAccumulator
+	for key, value := range hashMap.Iterator() {
+		accumulator = f(key, value, accumulator)
+	}
+
+	return accumulator
+}
+
+// Iterate over the keys and values of the map. Note the iteration order may not be the insertion order.
+// This method is not concurrency safe. For concurrent applications, consider using a mutex, or pull the data out using Keys() and Values().
+func (hashMap *CustomHashMap[K, V]) Iterator() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for index := range hashMap.mapData {
+			currentSlot := &hashMap.mapData[index]
+			if currentSlot.probeLength == 0 {
+				continue
+			}
+			if !yield(currentSlot.key, currentSlot.value) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,218 @@
+package customhashmap_test
+
+import (
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+
+	customhashmap "github.com/hmcalister/Go-DSA/map/CustomHashMap"
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
+	hasher "github.com/hmcalister/Go-DSA/utils/Hasher"
+)
+
+func newIntegerMap() *customhashmap.CustomHashMap[int, int] {
+	return customhashmap.New[int, int](hasher.DefaultIntegerHashFunction, hasher.DefaultEqualityFunction[int])
+}
+
+// a helper method to check the entries of an integer map against a Go map.
+//
+// calls t.Errorf if the map does not contain exactly the expected entries.
+func checkCustomHashMapEntries(t *testing.T, hashMap *customhashmap.CustomHashMap[int, int], expectedEntries map[int]int) {
+	t.Helper()
+
+	if hashMap.Size() != len(expectedEntries) {
+		t.Errorf("found size (%v) does not match expected size (%v)", hashMap.Size(), len(expectedEntries))
+	}
+	for key, expectedValue := range expectedEntries {
+		value, err := hashMap.Get(key)
+		if err != nil || value != expectedValue {
+			t.Errorf("found value (%v, %v) for key (%v) does not match expected value (%v, %v)", value, err, key, expectedValue, nil)
+		}
+	}
+
+	numEntries := 0
+	for key, value := range hashMap.Iterator() {
+		numEntries += 1
+		if expectedValue, ok := expectedEntries[key]; !ok || value != expectedValue {
+			t.Errorf("found unexpected entry (%v: %v) during iteration", key, value)
+		}
+	}
+	if numEntries != len(expectedEntries) {
+		t.Errorf("found number of iterated entries (%v) does not match expected number (%v)", numEntries, len(expectedEntries))
+	}
+}
+
+func TestCustomHashMapInit(t *testing.T) {
+	t.Run("int", func(t *testing.T) {
+		customhashmap.New[int, string](hasher.DefaultIntegerHashFunction, hasher.DefaultEqualityFunction[int])
+	})
+	t.Run("float", func(t *testing.T) {
+		customhashmap.New[float64, string](hasher.DefaultFloat64HashFunction, hasher.DefaultEqualityFunction[float64])
+	})
+	t.Run("string", func(t *testing.T) {
+		customhashmap.New[string, string](hasher.DefaultStringHashFunction, hasher.DefaultEqualityFunction[string])
+	})
+	t.Run("slice", func(t *testing.T) {
+		customhashmap.New[[]byte, string](hasher.DefaultBytesHashFunction, slices.Equal[[]byte])
+	})
+}
+
+func TestCustomHashMapPutGetRemove(t *testing.T) {
+	hashMap := newIntegerMap()
+
+	if _, err := hashMap.Get(1); !errors.Is(err, dsa_error.ErrorItemNotFound) {
+		t.Errorf("expected ErrorItemNotFound from empty map, found (%v)", err)
+	}
+	if _, replaced := hashMap.Put(1, 10); replaced {
+		t.Errorf("expected new key to not replace a value")
+	}
+	previousValue, replaced := hashMap.Put(1, 11)
+	if !replaced || previousValue != 10 {
+		t.Errorf("found replaced value (%v, %v) does not match expected (%v, %v)", previousValue, replaced, 10, true)
+	}
+	hashMap.Put(2, 20)
+	checkCustomHashMapEntries(t, hashMap, map[int]int{1: 11, 2: 20})
+
+	if !hashMap.ContainsKey(2) || hashMap.ContainsKey(3) {
+		t.Errorf("found unexpected result from ContainsKey")
+	}
+
+	value, err := hashMap.Remove(1)
+	if err != nil || value != 11 {
+		t.Errorf("found removed value (%v, %v) does not match expected (%v, %v)", value, err, 11, nil)
+	}
+	if _, err := hashMap.Remove(1); !errors.Is(err, dsa_error.ErrorItemNotFound) {
+		t.Errorf("expected ErrorItemNotFound when removing missing key, found (%v)", err)
+	}
+	checkCustomHashMapEntries(t, hashMap, map[int]int{2: 20})
+
+	hashMap.Clear()
+	checkCustomHashMapEntries(t, hashMap, map[int]int{})
+	hashMap.Put(3, 30)
+	checkCustomHashMapEntries(t, hashMap, map[int]int{3: 30})
+}
+
+func TestCustomHashMapRandomOperations(t *testing.T) {
+	randomGenerator := rand.New(rand.NewPCG(0, 0))
+	hashMap := newIntegerMap()
+	expectedEntries := make(map[int]int)
+
+	// Grow to a large size then shrink back down, to exercise resizing in both directions
+	for step := range 20000 {
+		key := randomGenerator.IntN(2000)
+		removeProbability := 0.3
+		if step >= 10000 {
+			removeProbability = 0.8
+		}
+
+		if randomGenerator.Float64() < removeProbability {
+			_, err := hashMap.Remove(key)
+			_, expectedPresent := expectedEntries[key]
+			if (err == nil) != expectedPresent {
+				t.Fatalf("step %v: found removal error (%v) for key (%v) but expected present (%v)", step, err, key, expectedPresent)
+			}
+			delete(expectedEntries, key)
+		} else {
+			_, replaced := hashMap.Put(key, step)
+			_, expectedPresent := expectedEntries[key]
+			if replaced != expectedPresent {
+				t.Fatalf("step %v: found replaced (%v) for key (%v) but expected present (%v)", step, replaced, key, expectedPresent)
+			}
+			expectedEntries[key] = step
+		}
+	}
+	checkCustomHashMapEntries(t, hashMap, expectedEntries)
+}
+
+func TestCustomHashMapCollidingHashes(t *testing.T) {
+	// Every key hashes to the same value, so every operation must probe past other keys
+	hashMap := customhashmap.New[int, int](func(item int) uint64 { return 7 }, hasher.DefaultEqualityFunction[int])
+	expectedEntries := make(map[int]int)
+	for key := range 50 {
+		hashMap.Put(key, -key)
+		expectedEntries[key] = -key
+	}
+	checkCustomHashMapEntries(t, hashMap, expectedEntries)
+
+	for key := 0; key < 50; key += 3 {
+		hashMap.Remove(key)
+		delete(expectedEntries, key)
+	}
+	checkCustomHashMapEntries(t, hashMap, expectedEntries)
+}
+
+func TestCustomHashMapSliceKeys(t *testing.T) {
+	hashMap := customhashmap.New[[]byte, string](hasher.DefaultBytesHashFunction, slices.Equal[[]byte])
+	hashMap.Put([]byte("hello"), "a")
+	hashMap.Put([]byte("world"), "b")
+
+	// A different slice with equal contents finds the same entry
+	value, err := hashMap.Get([]byte("hello"))
+	if err != nil || value != "a" {
+		t.Errorf("found value (%v, %v) does not match expected value (%v, %v)", value, err, "a", nil)
+	}
+	if hashMap.ContainsKey([]byte("hello!")) {
+		t.Errorf("found unexpected key")
+	}
+}
+
+func TestCustomHashMapCaseInsensitiveKeys(t *testing.T) {
+	hashMap := customhashmap.New[string, int](
+		func(item string) uint64 { return hasher.DefaultStringHashFunction(strings.ToLower(item)) },
+		strings.EqualFold,
+	)
+	hashMap.Put("Hello", 1)
+	previousValue, replaced := hashMap.Put("HELLO", 2)
+	if !replaced || previousValue != 1 {
+		t.Errorf("found replaced value (%v, %v) does not match expected (%v, %v)", previousValue, replaced, 1, true)
+	}
+
+	// The key already in the map is kept
+	keys := hashMap.Keys()
+	if !slices.Equal(keys, []string{"Hello"}) {
+		t.Errorf("found keys (%v) does not match expected keys (%v)", keys, []string{"Hello"})
+	}
+	if value, _ := hashMap.Get("hello"); value != 2 {
+		t.Errorf("found value (%v) does not match expected value (%v)", value, 2)
+	}
+}
+
+func TestCustomHashMapKeysValues(t *testing.T) {
+	hashMap := newIntegerMap()
+	for key := range 20 {
+		hashMap.Put(key, 2*key)
+	}
+
+	keys := hashMap.Keys()
+	values := hashMap.Values()
+	if len(keys) != 20 || len(values) != 20 {
+		t.Fatalf("found keys (%v) and values (%v) lengths do not match expected length (%v)", len(keys), len(values), 20)
+	}
+	for index := range keys {
+		if values[index] != 2*keys[index] {
+			t.Errorf("found value (%v) at index (%v) does not correspond to key (%v)", values[index], index, keys[index])
+		}
+	}
+}
+
+func TestCustomHashMapApplyFold(t *testing.T) {
+	hashMap := newIntegerMap()
+	for key := 1; key <= 10; key += 1 {
+		hashMap.Put(key, 2*key)
+	}
+
+	sum := 0
+	customhashmap.Apply(hashMap, func(key int, value int) { sum += key + value })
+	if sum != 165 {
+		t.Errorf("result (%v) does not match expected result (%v)", sum, 165)
+	}
+
+	foldedSum := customhashmap.Fold(hashMap, 0, func(key int, value int, accumulator int) int {
+		return accumulator + value
+	})
+	if foldedSum != 110 {
+		t.Errorf("result (%v) does not match expected result (%v)", foldedSum, 110)
+	}
+}
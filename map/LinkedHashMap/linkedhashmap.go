@@ -0,0 +1,272 @@
+package linkedhashmap
+
+import (
+	"iter"
+
+	linkedlist "github.com/hmcalister/Go-DSA/list/LinkedList"
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
+)
+
+// Determines the iteration order of a LinkedHashMap.
+type Order int
+
+const (
+	// Entries are ordered by when their key was first inserted. Updating the value of a key does not move it.
+	InsertionOrder Order = iota
+
+	// Entries are ordered by when they were last accessed, least recently accessed first.
+	// Get and Put (including updating an existing key) move the entry to the end.
+	AccessOrder Order = iota
+)
+
+// An entry of the map, stored in the ordering list.
+type entry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// An implementation of a hash map with predictable iteration order.
+//
+// A Go map indexes the nodes of a github.com/hmcalister/Go-DSA/list/LinkedList holding the entries.
+// Lookups go through the map, while iteration walks the list, so iteration follows insertion order
+// (or access order, as chosen at construction) rather than the randomised order of a Go map.
+// Put, Get, Remove, ContainsKey and MoveToEnd are all O(1).
+type LinkedHashMap[K comparable, V any] struct {
+	index     map[K]*linkedlist.LinkedListNode[*entry[K, V]]
+	entryList *linkedlist.LinkedList[*entry[K, V]]
+
+	order Order
+}
+
+// Create a new LinkedHashMap with the given iteration order.
+func New[K comparable, V any](order Order) *LinkedHashMap[K, V] {
+	return &LinkedHashMap[K, V]{
+		index:     make(map[K]*linkedlist.LinkedListNode[*entry[K, V]]),
+		entryList: linkedlist.New[*entry[K, V]](),
+		order:     order,
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Get Methods
+
+// Return the size of the map, the number of entries contained.
+func (hashMap *LinkedHashMap[K, V]) Size() int {
+	return len(hashMap.index)
+}
+
+// Get the iteration order of the map.
+func (hashMap *LinkedHashMap[K, V]) Order() Order {
+	return hashMap.order
+}
+
+// Get the value associated with a key. If the map is in AccessOrder, the entry is moved to the end.
+//
+// Returns a dsa_error.ErrorItemNotFound if the key is not present in the map.
+func (hashMap *LinkedHashMap[K, V]) Get(key K) (V, error) {
+	node, ok := hashMap.index[key]
+	if !ok {
+		return *new(V), dsa_error.ErrorItemNotFound
+	}
+
+	if hashMap.order == AccessOrder {
+		hashMap.entryList.MoveToBack(node)
+	}
+	return node.Item().value, nil
+}
+
+// Get the value associated with a key without affecting the order of the map, even if the map is in AccessOrder.
+//
+// Returns a dsa_error.ErrorItemNotFound if the key is not present in the map.
+func (hashMap *LinkedHashMap[K, V]) Peek(key K) (V, error) {
+	node, ok := hashMap.index[key]
+	if !ok {
+		return *new(V), dsa_error.ErrorItemNotFound
+	}
+
+	return node.Item().value, nil
+}
+
+// Checks if a key is present in the map. This does not affect the order of the map.
+func (hashMap *LinkedHashMap[K, V]) ContainsKey(key K) bool {
+	_, ok := hashMap.index[key]
+	return ok
+}
+
+// Get the first entry of the map: the least recently inserted (or accessed, in AccessOrder) entry.
+// This does not affect the order of the map.
+//
+// Returns a dsa_error.ErrorDataStructureEmpty if the map is empty.
+func (hashMap *LinkedHashMap[K, V]) Oldest() (K, V, error) {
+	node := hashMap.entryList.Front()
+	if node == nil {
+		return *new(K), *new(V), dsa_error.ErrorDataStructureEmpty
+	}
+
+	return node.Item().key, node.Item().value, nil
+}
+
+// Get the last entry of the map: the most recently inserted (or accessed, in AccessOrder) entry.
+// This does not affect the order of the map.
+//
+// Returns a dsa_error.ErrorDataStructureEmpty if the map is empty.
+func (hashMap *LinkedHashMap[K, V]) Newest() (K, V, error) {
+	node := hashMap.entryList.Back()
+	if node == nil {
+		return *new(K), *new(V), dsa_error.ErrorDataStructureEmpty
+	}
+
+	return node.Item().key, node.Item().value, nil
+}
+
+// Get all keys from the map, in order. This method allocates an array of length equal to the number of entries.
+func (hashMap *LinkedHashMap[K, V]) Keys() []K {
+	keys := make([]K, 0, hashMap.Size())
+	for key := range hashMap.Iterator() {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// Get all values from the map, in order. This method allocates an array of length equal to the number of entries.
+func (hashMap *LinkedHashMap[K, V]) Values() []V {
+	values := make([]V, 0, hashMap.Size())
+	for _, value := range hashMap.Iterator() {
+		values = append(values, value)
+	}
+	return values
+}
+
+// ----------------------------------------------------------------------------
+// Add Methods
+
+// Associate a value with a key, replacing any value already associated with the key.
+//
+// A new key is added at the end of the map. An existing key keeps its position in InsertionOrder,
+// or is moved to the end in AccessOrder.
+//
+// Returns (previousValue, true) if a value was replaced, or (*new(V), false) if the key was *not* already present.
+func (hashMap *LinkedHashMap[K, V]) Put(key K, value V) (V, bool) {
+	if node, ok := hashMap.index[key]; ok {
+		previousValue := node.Item().value
+		node.Item().value = value
+		if hashMap.order == AccessOrder {
+			hashMap.entryList.MoveToBack(node)
+		}
+		return previousValue, true
+	}
+
+	hashMap.entryList.Add(&entry[K, V]{
+		key:   key,
+		value: value,
+	})
+	hashMap.index[key] = hashMap.entryList.Back()
+	return *new(V), false
+}
+
+// Move the entry of a key to the end of the map, as if it were the most recently inserted (or accessed) entry.
+//
+// Returns a dsa_error.ErrorItemNotFound if the key is not present in the map.
+func (hashMap *LinkedHashMap[K, V]) MoveToEnd(key K) error {
+	node, ok := hashMap.index[key]
+	if !ok {
+		return dsa_error.ErrorItemNotFound
+	}
+
+	hashMap.entryList.MoveToBack(node)
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Remove Methods
+
+// Remove a key (and its associated value) from the map, returning the value.
+//
+// Returns a dsa_error.ErrorItemNotFound if the key is not present in the map.
+func (hashMap *LinkedHashMap[K, V]) Remove(key K) (V, error) {
+	node, ok := hashMap.index[key]
+	if !ok {
+		return *new(V), dsa_error.ErrorItemNotFound
+	}
+
+	delete(hashMap.index, key)
+	hashMap.entryList.RemoveNode(node)
+	return node.Item().value, nil
+}
+
+// Remove and return the first entry of the map: the least recently inserted (or accessed, in AccessOrder) entry.
+//
+// Returns a dsa_error.ErrorDataStructureEmpty if the map is empty.
+func (hashMap *LinkedHashMap[K, V]) RemoveOldest() (K, V, error) {
+	node := hashMap.entryList.Front()
+	if node == nil {
+		return *new(K), *new(V), dsa_error.ErrorDataStructureEmpty
+	}
+
+	removedEntry := node.Item()
+	delete(hashMap.index, removedEntry.key)
+	hashMap.entryList.RemoveNode(node)
+	return removedEntry.key, removedEntry.value, nil
+}
+
+// Remove all entries from the map.
+func (hashMap *LinkedHashMap[K, V]) Clear() {
+	hashMap.index = make(map[K]*linkedlist.LinkedListNode[*entry[K, V]])
+	hashMap.entryList = linkedlist.New[*entry[K, V]]()
+}
+
+// ----------------------------------------------------------------------------
+// Apply and Fold methods
+//
+// Methods to apply a function across ALL entries in a map.
+
+// Iterate over the entries of the map in order and apply a function to each key and value.
+//
+// Idiomatic Go should likely use Iterator() rather than functional methods.
+//
+// To accumulate values over entries, use Fold.
+func Apply[K comparable, V any](hashMap *LinkedHashMap[K, V], f func(key K, value V)) {
+	for key, value := range hashMap.Iterator() {
+		f(key, value)
+	}
+}
+
+// Iterate over the entries of the map in order and apply the function f.
+// The function f also takes the current value of the accumulator.
+// The results of f become the new value of the accumulator at each step.
+//
+// Idiomatic Go should likely use Iterator() rather than functional methods.
+//
+// This function is not a method on LinkedHashMap to allow for generic accumulators.
+func Fold[K comparable, V any, G any](hashMap *LinkedHashMap[K, V], initialAccumulator G, f func(key K, value V, accumulator G) G) G {
+	accumulator := initialAccumulator
+	for key, value := range hashMap.Iterator() {
+		accumulator = f(key, value, accumulator)
+	}
+
+	return accumulator
+}
+
+// Iterate over the keys and values of the map in order, oldest first. Iterating does not affect the order of the map.
+// This method is not concurrency safe. For concurrent applications, consider using a mutex, or pull the data out using Keys() and Values().
+func (hashMap *LinkedHashMap[K, V]) Iterator() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, currentEntry := range hashMap.entryList.ForwardIterator() {
+			if !yield(currentEntry.key, currentEntry.value) {
+				return
+			}
+		}
+	}
+}
+
+// Iterate over the keys and values of the map in reverse order, newest first. Iterating does not affect the order of the map.
+// This method is not concurrency safe. For concurrent applications, consider using a mutex, or pull the data out using Keys() and Values().
+func (hashMap *LinkedHashMap[K, V]) ReverseIterator() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, currentEntry := range hashMap.entryList.ReverseIterator() {
+			if !yield(currentEntry.key, currentEntry.value) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,273 @@
+package linkedhashmap_test
+
+import (
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	linkedhashmap "github.com/hmcalister/Go-DSA/map/LinkedHashMap"
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
+)
+
+// a helper method to check the keys of a map are exactly the expected keys, in order, in both directions.
+//
+// calls t.Errorf if the keys do not match.
+func checkLinkedHashMapKeys(t *testing.T, hashMap *linkedhashmap.LinkedHashMap[int, int], expectedKeys []int) {
+	t.Helper()
+
+	keys := hashMap.Keys()
+	if !slices.Equal(keys, expectedKeys) {
+		t.Errorf("found keys (%v) does not match expected keys (%v)", keys, expectedKeys)
+	}
+	if hashMap.Size() != len(expectedKeys) {
+		t.Errorf("found size (%v) does not match expected size (%v)", hashMap.Size(), len(expectedKeys))
+	}
+
+	reverseKeys := make([]int, 0)
+	for key := range hashMap.ReverseIterator() {
+		reverseKeys = append(reverseKeys, key)
+	}
+	slices.Reverse(reverseKeys)
+	if !slices.Equal(reverseKeys, expectedKeys) {
+		t.Errorf("found reversed keys (%v) does not match expected keys (%v)", reverseKeys, expectedKeys)
+	}
+}
+
+func TestLinkedHashMapInit(t *testing.T) {
+	t.Run("int", func(t *testing.T) {
+		linkedhashmap.New[int, string](linkedhashmap.InsertionOrder)
+	})
+	t.Run("float", func(t *testing.T) {
+		linkedhashmap.New[float64, string](linkedhashmap.InsertionOrder)
+	})
+	t.Run("string", func(t *testing.T) {
+		linkedhashmap.New[string, string](linkedhashmap.AccessOrder)
+	})
+	t.Run("struct", func(t *testing.T) {
+		type S struct {
+			_ int
+			_ float64
+			_ string
+		}
+		linkedhashmap.New[S, string](linkedhashmap.AccessOrder)
+	})
+}
+
+func TestLinkedHashMapPutGetRemove(t *testing.T) {
+	hashMap := linkedhashmap.New[int, int](linkedhashmap.InsertionOrder)
+
+	if _, err := hashMap.Get(1); !errors.Is(err, dsa_error.ErrorItemNotFound) {
+		t.Errorf("expected ErrorItemNotFound from empty map, found (%v)", err)
+	}
+	if _, replaced := hashMap.Put(1, 10); replaced {
+		t.Errorf("expected new key to not replace a value")
+	}
+	hashMap.Put(2, 20)
+	hashMap.Put(3, 30)
+	previousValue, replaced := hashMap.Put(1, 11)
+	if !replaced || previousValue != 10 {
+		t.Errorf("found replaced value (%v, %v) does not match expected (%v, %v)", previousValue, replaced, 10, true)
+	}
+
+	// Updating a key does not move it in insertion order
+	checkLinkedHashMapKeys(t, hashMap, []int{1, 2, 3})
+	if values := hashMap.Values(); !slices.Equal(values, []int{11, 20, 30}) {
+		t.Errorf("found values (%v) does not match expected values (%v)", values, []int{11, 20, 30})
+	}
+
+	value, err := hashMap.Get(2)
+	if err != nil || value != 20 {
+		t.Errorf("found value (%v, %v) does not match expected value (%v, %v)", value, err, 20, nil)
+	}
+	checkLinkedHashMapKeys(t, hashMap, []int{1, 2, 3})
+
+	value, err = hashMap.Remove(2)
+	if err != nil || value != 20 {
+		t.Errorf("found removed value (%v, %v) does not match expected (%v, %v)", value, err, 20, nil)
+	}
+	if _, err := hashMap.Remove(2); !errors.Is(err, dsa_error.ErrorItemNotFound) {
+		t.Errorf("expected ErrorItemNotFound when removing missing key, found (%v)", err)
+	}
+	if hashMap.ContainsKey(2) || !hashMap.ContainsKey(3) {
+		t.Errorf("found unexpected result from ContainsKey")
+	}
+	checkLinkedHashMapKeys(t, hashMap, []int{1, 3})
+
+	// A removed key is added at the end when put again
+	hashMap.Put(2, 21)
+	checkLinkedHashMapKeys(t, hashMap, []int{1, 3, 2})
+
+	hashMap.Clear()
+	checkLinkedHashMapKeys(t, hashMap, []int{})
+	hashMap.Put(4, 40)
+	checkLinkedHashMapKeys(t, hashMap, []int{4})
+}
+
+func TestLinkedHashMapAccessOrder(t *testing.T) {
+	hashMap := linkedhashmap.New[int, int](linkedhashmap.AccessOrder)
+	for key := range 5 {
+		hashMap.Put(key, key)
+	}
+	checkLinkedHashMapKeys(t, hashMap, []int{0, 1, 2, 3, 4})
+
+	hashMap.Get(1)
+	checkLinkedHashMapKeys(t, hashMap, []int{0, 2, 3, 4, 1})
+
+	hashMap.Put(0, 100)
+	checkLinkedHashMapKeys(t, hashMap, []int{2, 3, 4, 1, 0})
+
+	// Peek, ContainsKey, and iteration do not count as accesses
+	value, err := hashMap.Peek(2)
+	if err != nil || value != 2 {
+		t.Errorf("found peeked value (%v, %v) does not match expected value (%v, %v)", value, err, 2, nil)
+	}
+	hashMap.ContainsKey(3)
+	checkLinkedHashMapKeys(t, hashMap, []int{2, 3, 4, 1, 0})
+
+	// The least recently accessed entry is removed first
+	key, value, err := hashMap.RemoveOldest()
+	if err != nil || key != 2 || value != 2 {
+		t.Errorf("found removed entry (%v: %v, %v) does not match expected entry (%v: %v, %v)", key, value, err, 2, 2, nil)
+	}
+	checkLinkedHashMapKeys(t, hashMap, []int{3, 4, 1, 0})
+}
+
+func TestLinkedHashMapMoveToEnd(t *testing.T) {
+	hashMap := linkedhashmap.New[int, int](linkedhashmap.InsertionOrder)
+	for key := range 4 {
+		hashMap.Put(key, key)
+	}
+
+	if err := hashMap.MoveToEnd(0); err != nil {
+		t.Errorf("error when moving present key: %v", err)
+	}
+	checkLinkedHashMapKeys(t, hashMap, []int{1, 2, 3, 0})
+	if err := hashMap.MoveToEnd(0); err != nil {
+		t.Errorf("error when moving last key: %v", err)
+	}
+	checkLinkedHashMapKeys(t, hashMap, []int{1, 2, 3, 0})
+	if err := hashMap.MoveToEnd(10); !errors.Is(err, dsa_error.ErrorItemNotFound) {
+		t.Errorf("expected ErrorItemNotFound when moving missing key, found (%v)", err)
+	}
+}
+
+func TestLinkedHashMapOldestNewest(t *testing.T) {
+	hashMap := linkedhashmap.New[int, int](linkedhashmap.InsertionOrder)
+	if _, _, err := hashMap.Oldest(); !errors.Is(err, dsa_error.ErrorDataStructureEmpty) {
+		t.Errorf("expected ErrorDataStructureEmpty from empty map, found (%v)", err)
+	}
+	if _, _, err := hashMap.Newest(); !errors.Is(err, dsa_error.ErrorDataStructureEmpty) {
+		t.Errorf("expected ErrorDataStructureEmpty from empty map, found (%v)", err)
+	}
+	if _, _, err := hashMap.RemoveOldest(); !errors.Is(err, dsa_error.ErrorDataStructureEmpty) {
+		t.Errorf("expected ErrorDataStructureEmpty from empty map, found (%v)", err)
+	}
+
+	hashMap.Put(1, 10)
+	hashMap.Put(2, 20)
+	hashMap.Put(3, 30)
+	if key, value, err := hashMap.Oldest(); err != nil || key != 1 || value != 10 {
+		t.Errorf("found oldest entry (%v: %v, %v) does not match expected entry (%v: %v, %v)", key, value, err, 1, 10, nil)
+	}
+	if key, value, err := hashMap.Newest(); err != nil || key != 3 || value != 30 {
+		t.Errorf("found newest entry (%v: %v, %v) does not match expected entry (%v: %v, %v)", key, value, err, 3, 30, nil)
+	}
+
+	for _, expectedKey := range []int{1, 2, 3} {
+		key, _, err := hashMap.RemoveOldest()
+		if err != nil || key != expectedKey {
+			t.Errorf("found removed key (%v, %v) does not match expected key (%v, %v)", key, err, expectedKey, nil)
+		}
+	}
+	checkLinkedHashMapKeys(t, hashMap, []int{})
+}
+
+// Iteration order must match a reference order maintained by a slice, across random operations.
+func TestLinkedHashMapRandomOperations(t *testing.T) {
+	for _, order := range []linkedhashmap.Order{linkedhashmap.InsertionOrder, linkedhashmap.AccessOrder} {
+		randomGenerator := rand.New(rand.NewPCG(0, 0))
+		hashMap := linkedhashmap.New[int, int](order)
+		expectedKeys := make([]int, 0)
+		moveToEnd := func(key int) {
+			index := slices.Index(expectedKeys, key)
+			expectedKeys = append(slices.Delete(expectedKeys, index, index+1), key)
+		}
+
+		for step := range 2000 {
+			key := randomGenerator.IntN(50)
+			present := slices.Contains(expectedKeys, key)
+			switch randomGenerator.IntN(4) {
+			case 0:
+				hashMap.Put(key, step)
+				if !present {
+					expectedKeys = append(expectedKeys, key)
+				} else if order == linkedhashmap.AccessOrder {
+					moveToEnd(key)
+				}
+			case 1:
+				_, err := hashMap.Get(key)
+				if (err == nil) != present {
+					t.Fatalf("step %v: found error (%v) for key (%v) but expected present (%v)", step, err, key, present)
+				}
+				if present && order == linkedhashmap.AccessOrder {
+					moveToEnd(key)
+				}
+			case 2:
+				hashMap.Remove(key)
+				if present {
+					expectedKeys = slices.DeleteFunc(expectedKeys, func(item int) bool { return item == key })
+				}
+			case 3:
+				hashMap.MoveToEnd(key)
+				if present {
+					moveToEnd(key)
+				}
+			}
+		}
+		checkLinkedHashMapKeys(t, hashMap, expectedKeys)
+	}
+}
+
+func TestLinkedHashMapApplyFold(t *testing.T) {
+	hashMap := linkedhashmap.New[int, int](linkedhashmap.InsertionOrder)
+	for key := 1; key <= 10; key += 1 {
+		hashMap.Put(key, 2*key)
+	}
+
+	visitedKeys := make([]int, 0)
+	linkedhashmap.Apply(hashMap, func(key int, value int) { visitedKeys = append(visitedKeys, key) })
+	if !slices.Equal(visitedKeys, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}) {
+		t.Errorf("found visited keys (%v) are not in insertion order", visitedKeys)
+	}
+
+	// A fold that depends on the order of entries
+	digits := linkedhashmap.Fold(hashMap, 0, func(key int, value int, accumulator int) int {
+		return (10*accumulator + key) % 1000003
+	})
+	expectedDigits := 0
+	for key := 1; key <= 10; key += 1 {
+		expectedDigits = (10*expectedDigits + key) % 1000003
+	}
+	if digits != expectedDigits {
+		t.Errorf("result (%v) does not match expected result (%v)", digits, expectedDigits)
+	}
+}
+
+func TestLinkedHashMapIteratorEarlyExit(t *testing.T) {
+	hashMap := linkedhashmap.New[int, int](linkedhashmap.InsertionOrder)
+	for key := range 10 {
+		hashMap.Put(key, key)
+	}
+
+	numVisited := 0
+	for key := range hashMap.Iterator() {
+		numVisited += 1
+		if key == 4 {
+			break
+		}
+	}
+	if numVisited != 5 {
+		t.Errorf("found number of visited entries (%v) does not match expected number (%v)", numVisited, 5)
+	}
+}
@@ -0,0 +1,140 @@
+package multimap
+
+import (
+	"iter"
+
+	linkedlist "github.com/hmcalister/Go-DSA/list/LinkedList"
+	hashset "github.com/hmcalister/Go-DSA/set/HashSet"
+	redblacktree "github.com/hmcalister/Go-DSA/tree/RedBlackTree"
+	comparator "github.com/hmcalister/Go-DSA/utils/Comparator"
+)
+
+// The collection of values associated with a single key of a Multimap.
+type valueCollection[V any] interface {
+	// Add a value to the collection. Returns true if the collection changed.
+	add(value V) bool
+
+	// Remove one occurrence of a value from the collection. Returns true if the value was present.
+	remove(value V) bool
+
+	contains(value V) bool
+	size() int
+	iterator() iter.Seq[V]
+}
+
+// ----------------------------------------------------------------------------
+// List Collection
+
+// A collection of values in insertion order, allowing duplicate values.
+type listCollection[V comparable] struct {
+	values *linkedlist.LinkedList[V]
+}
+
+func newListCollection[V comparable]() valueCollection[V] {
+	return &listCollection[V]{
+		values: linkedlist.New[V](),
+	}
+}
+
+func (collection *listCollection[V]) add(value V) bool {
+	collection.values.Add(value)
+	return true
+}
+
+func (collection *listCollection[V]) remove(value V) bool {
+	_, err := collection.values.RemoveFirst(func(item V) bool { return item == value })
+	return err == nil
+}
+
+func (collection *listCollection[V]) contains(value V) bool {
+	_, err := collection.values.Find(func(item V) bool { return item == value })
+	return err == nil
+}
+
+func (collection *listCollection[V]) size() int {
+	return collection.values.Length()
+}
+
+func (collection *listCollection[V]) iterator() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, value := range collection.values.ForwardIterator() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// HashSet Collection
+
+// A collection of unique values in no particular order.
+type hashSetCollection[V comparable] struct {
+	values *hashset.HashSet[V]
+}
+
+func newHashSetCollection[V comparable]() valueCollection[V] {
+	return &hashSetCollection[V]{
+		values: hashset.New[V](),
+	}
+}
+
+func (collection *hashSetCollection[V]) add(value V) bool {
+	return collection.values.Add(value)
+}
+
+func (collection *hashSetCollection[V]) remove(value V) bool {
+	return collection.values.Remove(value) == nil
+}
+
+func (collection *hashSetCollection[V]) contains(value V) bool {
+	return collection.values.Contains(value)
+}
+
+func (collection *hashSetCollection[V]) size() int {
+	return collection.values.Size()
+}
+
+func (collection *hashSetCollection[V]) iterator() iter.Seq[V] {
+	return collection.values.Iterator()
+}
+
+// ----------------------------------------------------------------------------
+// Tree Collection
+
+// A collection of unique values in sorted order.
+type treeCollection[V any] struct {
+	values *redblacktree.RedBlackTree[V]
+}
+
+func newTreeCollectionFactory[V any](comparatorFunction comparator.ComparatorFunction[V]) func() valueCollection[V] {
+	return func() valueCollection[V] {
+		return &treeCollection[V]{
+			values: redblacktree.New(comparatorFunction),
+		}
+	}
+}
+
+func (collection *treeCollection[V]) add(value V) bool {
+	return collection.values.Add(value) == nil
+}
+
+func (collection *treeCollection[V]) remove(value V) bool {
+	return collection.values.Remove(value) == nil
+}
+
+func (collection *treeCollection[V]) contains(value V) bool {
+	_, err := collection.values.Find(value)
+	return err == nil
+}
+
+func (collection *treeCollection[V]) size() int {
+	if collection.values.Root() == nil {
+		return 0
+	}
+	return collection.values.Root().Size()
+}
+
+func (collection *treeCollection[V]) iterator() iter.Seq[V] {
+	return redblacktree.IteratorTreeInorder(collection.values)
+}
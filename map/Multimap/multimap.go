@@ -0,0 +1,261 @@
+package multimap
+
+import (
+	"iter"
+
+	comparator "github.com/hmcalister/Go-DSA/utils/Comparator"
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
+)
+
+// An implementation of a multimap, mapping each key to a collection of values.
+//
+// The kind of collection holding the values of each key is chosen by the constructor:
+//   - NewListMultimap keeps values in insertion order, allowing duplicate values for a key.
+//   - NewHashSetMultimap keeps unique values for each key, in no particular order.
+//   - NewTreeMultimap keeps unique values for each key, sorted by a ComparatorFunction.
+//
+// Keys with no values are removed, so every key present has at least one value.
+type Multimap[K comparable, V any] struct {
+	multimapData map[K]valueCollection[V]
+
+	// Create a new, empty collection for the values of a key.
+	newCollection func() valueCollection[V]
+
+	// The total number of key-value entries.
+	size int
+}
+
+// Create a new Multimap storing the values of each key in a list.
+// Values are kept in insertion order, and the same value may be associated with a key many times.
+func NewListMultimap[K comparable, V comparable]() *Multimap[K, V] {
+	return &Multimap[K, V]{
+		multimapData:  make(map[K]valueCollection[V]),
+		newCollection: newListCollection[V],
+		size:          0,
+	}
+}
+
+// Create a new Multimap storing the values of each key in a github.com/hmcalister/Go-DSA/set/HashSet.
+// A value is associated with a key at most once, and the values of a key are in no particular order.
+func NewHashSetMultimap[K comparable, V comparable]() *Multimap[K, V] {
+	return &Multimap[K, V]{
+		multimapData:  make(map[K]valueCollection[V]),
+		newCollection: newHashSetCollection[V],
+		size:          0,
+	}
+}
+
+// Create a new Multimap storing the values of each key in a github.com/hmcalister/Go-DSA/tree/RedBlackTree.
+// A value is associated with a key at most once, and the values of a key are sorted by the comparator function.
+func NewTreeMultimap[K comparable, V any](comparatorFunction comparator.ComparatorFunction[V]) *Multimap[K, V] {
+	return &Multimap[K, V]{
+		multimapData:  make(map[K]valueCollection[V]),
+		newCollection: newTreeCollectionFactory(comparatorFunction),
+		size:          0,
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Get Methods
+
+// Return the size of the multimap, the total number of key-value entries.
+func (multimap *Multimap[K, V]) Size() int {
+	return multimap.size
+}
+
+// Return the number of distinct keys in the multimap.
+func (multimap *Multimap[K, V]) KeySize() int {
+	return len(multimap.multimapData)
+}
+
+// Return the number of values associated with a key. Returns zero if the key is not present.
+func (multimap *Multimap[K, V]) ValueCount(key K) int {
+	collection, ok := multimap.multimapData[key]
+	if !ok {
+		return 0
+	}
+	return collection.size()
+}
+
+// Get the values associated with a key, in the order of the value collection.
+// This method allocates an array of length equal to the number of values of the key.
+//
+// Returns an empty slice if the key is not present.
+func (multimap *Multimap[K, V]) Get(key K) []V {
+	collection, ok := multimap.multimapData[key]
+	if !ok {
+		return []V{}
+	}
+
+	values := make([]V, 0, collection.size())
+	for value := range collection.iterator() {
+		values = append(values, value)
+	}
+	return values
+}
+
+// Checks if a key has at least one associated value.
+func (multimap *Multimap[K, V]) ContainsKey(key K) bool {
+	_, ok := multimap.multimapData[key]
+	return ok
+}
+
+// Checks if a value is associated with a key.
+func (multimap *Multimap[K, V]) ContainsEntry(key K, value V) bool {
+	collection, ok := multimap.multimapData[key]
+	return ok && collection.contains(value)
+}
+
+// Get all distinct keys from the multimap. This method allocates an array of length equal to the number of keys.
+// The keys are not guaranteed to be in the order they were inserted into the multimap.
+func (multimap *Multimap[K, V]) Keys() []K {
+	keys := make([]K, 0, len(multimap.multimapData))
+	for key := range multimap.multimapData {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// ----------------------------------------------------------------------------
+// Add Methods
+
+// Associate a value with a key.
+//
+// Returns true if the multimap changed. For list multimaps this is always true,
+// while for set multimaps this is false if the value was already associated with the key.
+func (multimap *Multimap[K, V]) Put(key K, value V) bool {
+	collection, ok := multimap.multimapData[key]
+	if !ok {
+		collection = multimap.newCollection()
+		multimap.multimapData[key] = collection
+	}
+
+	if !collection.add(value) {
+		return false
+	}
+	multimap.size += 1
+	return true
+}
+
+// ----------------------------------------------------------------------------
+// Remove Methods
+
+// Remove a value from the values associated with a key. For list multimaps, only the first occurrence of the value is removed.
+//
+// Returns a dsa_error.ErrorItemNotFound if the value is not associated with the key.
+func (multimap *Multimap[K, V]) Remove(key K, value V) error {
+	collection, ok := multimap.multimapData[key]
+	if !ok || !collection.remove(value) {
+		return dsa_error.ErrorItemNotFound
+	}
+
+	multimap.size -= 1
+	if collection.size() == 0 {
+		delete(multimap.multimapData, key)
+	}
+	return nil
+}
+
+// Remove a key and all of its associated values, returning the removed values in the order of the value collection.
+//
+// Returns a dsa_error.ErrorItemNotFound if the key is not present.
+func (multimap *Multimap[K, V]) RemoveAll(key K) ([]V, error) {
+	if !multimap.ContainsKey(key) {
+		return nil, dsa_error.ErrorItemNotFound
+	}
+
+	values := multimap.Get(key)
+	delete(multimap.multimapData, key)
+	multimap.size -= len(values)
+	return values, nil
+}
+
+// Remove all entries from the multimap.
+func (multimap *Multimap[K, V]) Clear() {
+	multimap.multimapData = make(map[K]valueCollection[V])
+	multimap.size = 0
+}
+
+// ----------------------------------------------------------------------------
+// Apply, Fold, and Iterator methods
+
+// Iterate over the entries of the multimap and apply a function to each key and value.
+//
+// Idiomatic Go should likely use Iterator() rather than functional methods.
+//
+// BEWARE: Keys are iterated in no particular order. The values of each key are
+// iterated in the order of the value collection.
+//
+// To accumulate values over entries, use Fold.
+func Apply[K comparable, V any](multimap *Multimap[K, V], f func(key K, value V)) {
+	for key, value := range multimap.Iterator() {
+		f(key, value)
+	}
+}
+
+// Iterate over the entries of the multimap and apply the function f.
+// The function f also takes the current value of the accumulator.
+// The results of f become the new value of the accumulator at each step.
+//
+// Idiomatic Go should likely use Iterator() rather than functional methods.
+//
+// BEWARE: Keys are iterated in no particular order. The values of each key are
+// iterated in the order of the value collection. Ensure your function accounts for this.
+//
+// This function is not a method on Multimap to allow for generic accumulators.
+func Fold[K comparable, V any, G any](multimap *Multimap[K, V], initialAccumulator G, f func(key K, value V, accumulator G) G) G {
+	accumulator := initialAccumulator
+	for key, value := range multimap.Iterator() {
+		accumulator = f(key, value, accumulator)
+	}
+
+	return accumulator
+}
+
+// Iterate over every key-value entry of the multimap. A key with many values is yielded once for each value.
+// Keys are iterated in no particular order, and the values of each key in the order of the value collection.
+// This method is not concurrency safe. For concurrent applications, consider using a mutex.
+func (multimap *Multimap[K, V]) Iterator() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for key, collection := range multimap.multimapData {
+			for value := range collection.iterator() {
+				if !yield(key, value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Iterate over the distinct keys of the multimap, along with the number of values associated with each key.
+// Keys are iterated in no particular order.
+// This method is not concurrency safe. For concurrent applications, consider using a mutex, or pull the data out using Keys().
+func (multimap *Multimap[K, V]) KeyIterator() iter.Seq2[K, int] {
+	return func(yield func(K, int) bool) {
+		for key, collection := range multimap.multimapData {
+			if !yield(key, collection.size()) {
+				return
+			}
+		}
+	}
+}
+
+// Iterate over the values associated with a key, in the order of the value collection, along with the index of each value.
+// If the key is not present, the iterator yields nothing.
+// This method is not concurrency safe. For concurrent applications, consider using a mutex, or pull the data out using Get().
+func (multimap *Multimap[K, V]) ValueIterator(key K) iter.Seq2[int, V] {
+	return func(yield func(int, V) bool) {
+		collection, ok := multimap.multimapData[key]
+		if !ok {
+			return
+		}
+
+		index := 0
+		for value := range collection.iterator() {
+			if !yield(index, value) {
+				return
+			}
+			index += 1
+		}
+	}
+}
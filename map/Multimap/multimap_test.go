@@ -0,0 +1,205 @@
+package multimap_test
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"testing"
+
+	multimap "github.com/hmcalister/Go-DSA/map/Multimap"
+	comparator "github.com/hmcalister/Go-DSA/utils/Comparator"
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
+)
+
+// a helper method to check the values of each key of a multimap.
+// The values of each key are compared in order, so for hash set multimaps the expected values should be sorted.
+//
+// calls t.Errorf if the multimap does not contain exactly the expected entries.
+func checkMultimapEntries(t *testing.T, mm *multimap.Multimap[string, int], sortValues bool, expectedEntries map[string][]int) {
+	t.Helper()
+
+	expectedSize := 0
+	for key, expectedValues := range expectedEntries {
+		expectedSize += len(expectedValues)
+		values := mm.Get(key)
+		if sortValues {
+			slices.Sort(values)
+		}
+		if !slices.Equal(values, expectedValues) {
+			t.Errorf("found values (%v) of key (%v) does not match expected values (%v)", values, key, expectedValues)
+		}
+		if mm.ValueCount(key) != len(expectedValues) {
+			t.Errorf("found value count (%v) of key (%v) does not match expected count (%v)", mm.ValueCount(key), key, len(expectedValues))
+		}
+	}
+	if mm.Size() != expectedSize {
+		t.Errorf("found size (%v) does not match expected size (%v)", mm.Size(), expectedSize)
+	}
+	if mm.KeySize() != len(expectedEntries) {
+		t.Errorf("found key size (%v) does not match expected key size (%v)", mm.KeySize(), len(expectedEntries))
+	}
+
+	numEntries := 0
+	for key, value := range mm.Iterator() {
+		numEntries += 1
+		if !slices.Contains(expectedEntries[key], value) {
+			t.Errorf("found unexpected entry (%v: %v) during iteration", key, value)
+		}
+	}
+	if numEntries != expectedSize {
+		t.Errorf("found number of iterated entries (%v) does not match expected number (%v)", numEntries, expectedSize)
+	}
+}
+
+func TestMultimapInit(t *testing.T) {
+	t.Run("list", func(t *testing.T) {
+		multimap.NewListMultimap[string, int]()
+	})
+	t.Run("hash set", func(t *testing.T) {
+		multimap.NewHashSetMultimap[string, float64]()
+	})
+	t.Run("tree", func(t *testing.T) {
+		multimap.NewTreeMultimap[string](comparator.DefaultStringComparator)
+	})
+	t.Run("tree struct", func(t *testing.T) {
+		type S struct {
+			i int
+			_ []string
+		}
+		multimap.NewTreeMultimap[int](func(a, b S) int { return a.i - b.i })
+	})
+}
+
+func TestListMultimap(t *testing.T) {
+	mm := multimap.NewListMultimap[string, int]()
+	mm.Put("a", 3)
+	mm.Put("a", 1)
+	mm.Put("b", 2)
+	if !mm.Put("a", 3) {
+		t.Errorf("expected putting duplicate entry into list multimap to return true")
+	}
+	checkMultimapEntries(t, mm, false, map[string][]int{"a": {3, 1, 3}, "b": {2}})
+
+	// Only the first occurrence is removed
+	if err := mm.Remove("a", 3); err != nil {
+		t.Errorf("error when removing present entry: %v", err)
+	}
+	checkMultimapEntries(t, mm, false, map[string][]int{"a": {1, 3}, "b": {2}})
+
+	if err := mm.Remove("a", 5); !errors.Is(err, dsa_error.ErrorItemNotFound) {
+		t.Errorf("expected ErrorItemNotFound when removing missing value, found (%v)", err)
+	}
+	if err := mm.Remove("c", 1); !errors.Is(err, dsa_error.ErrorItemNotFound) {
+		t.Errorf("expected ErrorItemNotFound when removing from missing key, found (%v)", err)
+	}
+
+	// Removing the last value removes the key
+	mm.Remove("b", 2)
+	if mm.ContainsKey("b") {
+		t.Errorf("expected key with no values to be removed")
+	}
+	checkMultimapEntries(t, mm, false, map[string][]int{"a": {1, 3}})
+}
+
+func TestHashSetMultimap(t *testing.T) {
+	mm := multimap.NewHashSetMultimap[string, int]()
+	mm.Put("a", 3)
+	mm.Put("a", 1)
+	if mm.Put("a", 3) {
+		t.Errorf("expected putting duplicate entry into hash set multimap to return false")
+	}
+	mm.Put("b", 2)
+	checkMultimapEntries(t, mm, true, map[string][]int{"a": {1, 3}, "b": {2}})
+
+	if !mm.ContainsEntry("a", 1) || mm.ContainsEntry("a", 2) || mm.ContainsEntry("c", 1) {
+		t.Errorf("found unexpected result from ContainsEntry")
+	}
+
+	mm.Remove("a", 3)
+	checkMultimapEntries(t, mm, true, map[string][]int{"a": {1}, "b": {2}})
+}
+
+func TestTreeMultimap(t *testing.T) {
+	mm := multimap.NewTreeMultimap[string](comparator.DefaultIntegerComparator)
+	for _, value := range []int{5, 2, 8, 2, 1} {
+		mm.Put("a", value)
+	}
+	mm.Put("b", 0)
+
+	// Values are sorted without needing to sort them in the check
+	checkMultimapEntries(t, mm, false, map[string][]int{"a": {1, 2, 5, 8}, "b": {0}})
+
+	indexedValues := make([]int, 0)
+	for index, value := range mm.ValueIterator("a") {
+		if index != len(indexedValues) {
+			t.Errorf("found index (%v) does not match expected index (%v)", index, len(indexedValues))
+		}
+		indexedValues = append(indexedValues, value)
+	}
+	if !slices.Equal(indexedValues, []int{1, 2, 5, 8}) {
+		t.Errorf("found iterated values (%v) does not match expected values (%v)", indexedValues, []int{1, 2, 5, 8})
+	}
+	for range mm.ValueIterator("missing") {
+		t.Errorf("expected no values for missing key")
+	}
+}
+
+func TestMultimapRemoveAll(t *testing.T) {
+	mm := multimap.NewListMultimap[string, int]()
+	mm.Put("a", 1)
+	mm.Put("a", 2)
+	mm.Put("b", 3)
+
+	values, err := mm.RemoveAll("a")
+	if err != nil || !slices.Equal(values, []int{1, 2}) {
+		t.Errorf("found removed values (%v, %v) does not match expected values (%v, %v)", values, err, []int{1, 2}, nil)
+	}
+	if _, err := mm.RemoveAll("a"); !errors.Is(err, dsa_error.ErrorItemNotFound) {
+		t.Errorf("expected ErrorItemNotFound when removing missing key, found (%v)", err)
+	}
+	checkMultimapEntries(t, mm, false, map[string][]int{"b": {3}})
+
+	mm.Clear()
+	checkMultimapEntries(t, mm, false, map[string][]int{})
+	if values := mm.Get("b"); len(values) != 0 {
+		t.Errorf("expected no values for missing key, found (%v)", values)
+	}
+}
+
+func TestMultimapKeyIterator(t *testing.T) {
+	mm := multimap.NewListMultimap[string, int]()
+	mm.Put("a", 1)
+	mm.Put("a", 1)
+	mm.Put("b", 2)
+
+	keyCounts := maps.Collect(mm.KeyIterator())
+	if !maps.Equal(keyCounts, map[string]int{"a": 2, "b": 1}) {
+		t.Errorf("found key counts (%v) does not match expected counts (%v)", keyCounts, map[string]int{"a": 2, "b": 1})
+	}
+	keys := mm.Keys()
+	slices.Sort(keys)
+	if !slices.Equal(keys, []string{"a", "b"}) {
+		t.Errorf("found keys (%v) does not match expected keys (%v)", keys, []string{"a", "b"})
+	}
+}
+
+func TestMultimapApplyFold(t *testing.T) {
+	mm := multimap.NewListMultimap[string, int]()
+	for value := 1; value <= 10; value += 1 {
+		mm.Put("key", value)
+	}
+	mm.Put("other", 100)
+
+	sum := 0
+	multimap.Apply(mm, func(key string, value int) { sum += value })
+	if sum != 155 {
+		t.Errorf("result (%v) does not match expected result (%v)", sum, 155)
+	}
+
+	keyLengths := multimap.Fold(mm, 0, func(key string, value int, accumulator int) int {
+		return accumulator + len(key)
+	})
+	if keyLengths != 35 {
+		t.Errorf("result (%v) does not match expected result (%v)", keyLengths, 35)
+	}
+}
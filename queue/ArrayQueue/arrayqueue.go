@@ -3,23 +3,25 @@ package arrayqueue
 import (
 	"iter"
 
+	circularbuffer "github.com/hmcalister/Go-DSA/queue/internal/CircularBuffer"
 	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
 )
 
 // Implement a queue using a array / slice.
 //
 // Queues are a first in, first out data structure. Items added to the queue are removed in the order they were added.
+//
+// The array is used as a circular buffer (ring buffer), so adding and removing items is amortized O(1)
+// and the memory of removed items is reclaimed. The backing array doubles in size when full,
+// and halves in size when at most a quarter full.
 type ArrayQueue[T any] struct {
-	queueData []T
+	queueData circularbuffer.CircularBuffer[T]
 }
 
-// Create a new ArrayQueue using github.com/hmcalister/Go-DSA/list/ArrayQueue as a backing data structure.
+// Create a new ArrayQueue using an array as a circular buffer.
 func New[T any]() *ArrayQueue[T] {
 	return &ArrayQueue[T]{
-		// Slices are backed by arrays which grow with a growth factor of 2.
-		//
-		// This will be fine for our purposes.
-		queueData: make([]T, 0),
+		queueData: circularbuffer.New[T](),
 	}
 }
 
@@ -30,12 +32,11 @@ func New[T any]() *ArrayQueue[T] {
 //
 // Returns a dsa_error.ErrorDataStructureEmpty if the queue is empty.
 func (queue *ArrayQueue[T]) Peek() (T, error) {
-	if len(queue.queueData) == 0 {
+	if queue.queueData.Size() == 0 {
 		return *new(T), dsa_error.ErrorDataStructureEmpty
 	}
 
-	item := queue.queueData[0]
-	return item, nil
+	return queue.queueData.At(0), nil
 }
 
 // Find the first item in a queue matching a predicate.
@@ -43,7 +44,8 @@ func (queue *ArrayQueue[T]) Peek() (T, error) {
 //
 // Returns (item, nil) if the item is present, or (*new(T), dsa_error.ErrorItemNotFound) if the item is not present.
 func (queue *ArrayQueue[T]) Find(predicate func(item T) bool) (T, error) {
-	for _, item := range queue.queueData {
+	for index := 0; index < queue.queueData.Size(); index += 1 {
+		item := queue.queueData.At(index)
 		if predicate(item) {
 			return item, nil
 		}
@@ -57,7 +59,8 @@ func (queue *ArrayQueue[T]) Find(predicate func(item T) bool) (T, error) {
 // Returns all items from the queue that match the predicate.
 func (queue *ArrayQueue[T]) FindAll(predicate func(item T) bool) []T {
 	foundItems := make([]T, 0)
-	for _, item := range queue.queueData {
+	for index := 0; index < queue.queueData.Size(); index += 1 {
+		item := queue.queueData.At(index)
 		if predicate(item) {
 			foundItems = append(foundItems, item)
 		}
@@ -67,14 +70,16 @@ func (queue *ArrayQueue[T]) FindAll(predicate func(item T) bool) []T {
 
 // Get all items from the queue. This method allocates an array of length equal to the number of items.
 func (queue *ArrayQueue[T]) Items() []T {
-	items := make([]T, queue.Size())
-	copy(items, queue.queueData)
+	items := make([]T, queue.queueData.Size())
+	for index := 0; index < queue.queueData.Size(); index += 1 {
+		items[index] = queue.queueData.At(index)
+	}
 	return items
 }
 
 // Get the size of the queue, the number of items in the queue.
 func (queue *ArrayQueue[T]) Size() int {
-	return len(queue.queueData)
+	return queue.queueData.Size()
 }
 
 // ----------------------------------------------------------------------------
@@ -82,7 +87,7 @@ func (queue *ArrayQueue[T]) Size() int {
 
 // Enqueue an item, adding it to the end of the queue.
 func (queue *ArrayQueue[T]) Add(item T) {
-	queue.queueData = append(queue.queueData, item)
+	queue.queueData.PushBack(item)
 }
 
 // ----------------------------------------------------------------------------
@@ -92,13 +97,55 @@ func (queue *ArrayQueue[T]) Add(item T) {
 //
 // Returns a dsa_error.ErrorDataStructureEmpty error if the queue is empty.
 func (queue *ArrayQueue[T]) Remove() (T, error) {
-	if len(queue.queueData) == 0 {
+	if queue.queueData.Size() == 0 {
 		return *new(T), dsa_error.ErrorDataStructureEmpty
 	}
 
-	item := queue.queueData[0]
-	queue.queueData = queue.queueData[1:]
-	return item, nil
+	return queue.queueData.PopFront(), nil
+}
+
+// Remove and return the first item in the queue matching a predicate.
+// The queue is traversed from front to back.
+//
+// Returns (item, nil) if an item was removed, or (*new(T), dsa_error.ErrorItemNotFound) if no item matches.
+func (queue *ArrayQueue[T]) RemoveFirst(predicate func(item T) bool) (T, error) {
+	for index := 0; index < queue.queueData.Size(); index += 1 {
+		if predicate(queue.queueData.At(index)) {
+			return queue.queueData.RemoveAt(index), nil
+		}
+	}
+	return *new(T), dsa_error.ErrorItemNotFound
+}
+
+// Remove all items in the queue matching a predicate. The remaining items keep their order.
+// The queue is traversed once from front to back, testing each item exactly once.
+//
+// Returns the number of items removed.
+func (queue *ArrayQueue[T]) RemoveAll(predicate func(item T) bool) int {
+	// Compact the kept items towards the front of the queue
+	keptCount := 0
+	for index := 0; index < queue.queueData.Size(); index += 1 {
+		item := queue.queueData.At(index)
+		if predicate(item) {
+			continue
+		}
+		queue.queueData.Set(keptCount, item)
+		keptCount += 1
+	}
+
+	numRemoved := queue.queueData.Size() - keptCount
+	queue.queueData.Truncate(keptCount)
+	return numRemoved
+}
+
+// Keep only the items in the queue matching a predicate, removing all others. The remaining items keep their order.
+// The queue is traversed once from front to back, testing each item exactly once.
+//
+// Returns the number of items removed.
+func (queue *ArrayQueue[T]) Retain(predicate func(item T) bool) int {
+	return queue.RemoveAll(func(item T) bool {
+		return !predicate(item)
+	})
 }
 
 // ----------------------------------------------------------------------------
@@ -114,8 +161,8 @@ func (queue *ArrayQueue[T]) Remove() (T, error) {
 // To modify the queue items, use ForwardMap.
 // To accumulate values over the queue, use ForwardFold.
 func ForwardApply[T any](queue *ArrayQueue[T], f func(item T)) {
-	for index := 0; index < len(queue.queueData); index += 1 {
-		f(queue.queueData[index])
+	for index := 0; index < queue.queueData.Size(); index += 1 {
+		f(queue.queueData.At(index))
 	}
 }
 
@@ -128,8 +175,8 @@ func ForwardApply[T any](queue *ArrayQueue[T], f func(item T)) {
 // If you do not need to modify the queue items, use ForwardApply.
 // To accumulate values over the queue, use ForwardFold.
 func ForwardMap[T any](queue *ArrayQueue[T], f func(item T) T) {
-	for index := 0; index < len(queue.queueData); index += 1 {
-		queue.queueData[index] = f(queue.queueData[index])
+	for index := 0; index < queue.queueData.Size(); index += 1 {
+		queue.queueData.Set(index, f(queue.queueData.At(index)))
 	}
 }
 
@@ -144,8 +191,8 @@ func ForwardMap[T any](queue *ArrayQueue[T], f func(item T) T) {
 // This function is not a method on ArrayQueue to allow for generic accumulators.
 func ForwardFold[T any, G any](queue *ArrayQueue[T], initialAccumulator G, f func(item T, accumulator G) G) G {
 	accumulator := initialAccumulator
-	for index := 0; index < len(queue.queueData); index += 1 {
-		accumulator = f(queue.queueData[index], accumulator)
+	for index := 0; index < queue.queueData.Size(); index += 1 {
+		accumulator = f(queue.queueData.At(index), accumulator)
 	}
 
 	return accumulator
@@ -159,8 +206,8 @@ func ForwardFold[T any, G any](queue *ArrayQueue[T], initialAccumulator G, f fun
 // To modify the queue items, use ReverseMap.
 // To accumulate values over the queue, use ReverseFold.
 func ReverseApply[T any](queue *ArrayQueue[T], f func(item T)) {
-	for index := len(queue.queueData) - 1; index >= 0; index -= 1 {
-		f(queue.queueData[index])
+	for index := queue.queueData.Size() - 1; index >= 0; index -= 1 {
+		f(queue.queueData.At(index))
 	}
 }
 
@@ -173,8 +220,8 @@ func ReverseApply[T any](queue *ArrayQueue[T], f func(item T)) {
 // If you do not need to modify the queue items, use ReverseApply.
 // To accumulate values over the queue, use ReverseFold.
 func ReverseMap[T any](queue *ArrayQueue[T], f func(item T) T) {
-	for index := len(queue.queueData) - 1; index >= 0; index -= 1 {
-		queue.queueData[index] = f(queue.queueData[index])
+	for index := queue.queueData.Size() - 1; index >= 0; index -= 1 {
+		queue.queueData.Set(index, f(queue.queueData.At(index)))
 	}
 }
 
@@ -189,8 +236,8 @@ func ReverseMap[T any](queue *ArrayQueue[T], f func(item T) T) {
 // This function is not a method on ArrayQueue to allow for generic accumulators.
 func ReverseFold[T any, G any](queue *ArrayQueue[T], initialAccumulator G, f func(item T, accumulator G) G) G {
 	accumulator := initialAccumulator
-	for index := len(queue.queueData) - 1; index >= 0; index -= 1 {
-		accumulator = f(queue.queueData[index], accumulator)
+	for index := queue.queueData.Size() - 1; index >= 0; index -= 1 {
+		accumulator = f(queue.queueData.At(index), accumulator)
 	}
 
 	return accumulator
@@ -201,8 +248,8 @@ func ReverseFold[T any, G any](queue *ArrayQueue[T], initialAccumulator G, f fun
 // This method is not concurrency safe. For concurrent applications, consider using a mutex, or pull the data out using Items().
 func (queue *ArrayQueue[T]) ForwardIterator() iter.Seq2[int, T] {
 	return func(yield func(int, T) bool) {
-		for index := 0; index < len(queue.queueData); index += 1 {
-			item := queue.queueData[index]
+		for index := 0; index < queue.queueData.Size(); index += 1 {
+			item := queue.queueData.At(index)
 			if !yield(index, item) {
 				break
 			}
@@ -215,8 +262,8 @@ func (queue *ArrayQueue[T]) ForwardIterator() iter.Seq2[int, T] {
 // This method is not concurrency safe. For concurrent applications, consider using a mutex, or pull the data out using Items().
 func (queue *ArrayQueue[T]) ReverseIterator() iter.Seq2[int, T] {
 	return func(yield func(int, T) bool) {
-		for index := 0; index < len(queue.queueData); index += 1 {
-			item := queue.queueData[len(queue.queueData)-index-1]
+		for index := 0; index < queue.queueData.Size(); index += 1 {
+			item := queue.queueData.At(queue.queueData.Size() - index - 1)
 			if !yield(index, item) {
 				break
 			}
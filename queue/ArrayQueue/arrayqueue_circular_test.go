@@ -0,0 +1,186 @@
+package arrayqueue_test
+
+import (
+	"errors"
+	"math/rand"
+	"slices"
+	"testing"
+
+	arrayqueue "github.com/hmcalister/Go-DSA/queue/ArrayQueue"
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
+)
+
+// Check the queue against a slice holding the expected items, front first.
+func checkArrayQueueItems(t *testing.T, queue *arrayqueue.ArrayQueue[int], expectedItems []int) {
+	t.Helper()
+
+	if queue.Size() != len(expectedItems) {
+		t.Fatalf("found queue size (%v) does not match the expected size (%v)", queue.Size(), len(expectedItems))
+	}
+
+	if !slices.Equal(expectedItems, queue.Items()) {
+		t.Fatalf("found items %v do not match expected items %v", queue.Items(), expectedItems)
+	}
+
+	forwardItems := make([]int, 0)
+	for index, item := range queue.ForwardIterator() {
+		if index != len(forwardItems) {
+			t.Fatalf("forward iterator index (%v) does not match expected index (%v)", index, len(forwardItems))
+		}
+		forwardItems = append(forwardItems, item)
+	}
+	if !slices.Equal(expectedItems, forwardItems) {
+		t.Fatalf("forward iterator items %v do not match expected items %v", forwardItems, expectedItems)
+	}
+
+	reverseItems := make([]int, 0)
+	for _, item := range queue.ReverseIterator() {
+		reverseItems = append(reverseItems, item)
+	}
+	slices.Reverse(reverseItems)
+	if !slices.Equal(expectedItems, reverseItems) {
+		t.Fatalf("reverse iterator items %v do not match expected items %v", reverseItems, expectedItems)
+	}
+}
+
+func TestArrayQueueWrapAround(t *testing.T) {
+	queue := arrayqueue.New[int]()
+	expectedItems := make([]int, 0)
+
+	// Repeatedly add two and remove one, so the front of the queue moves through the backing array
+	nextItem := 0
+	for range 50 {
+		queue.Add(nextItem)
+		queue.Add(nextItem + 1)
+		expectedItems = append(expectedItems, nextItem, nextItem+1)
+		nextItem += 2
+
+		removedItem, err := queue.Remove()
+		if err != nil {
+			t.Fatalf("encountered error (%v) when removing from non-empty queue", err)
+		}
+		if removedItem != expectedItems[0] {
+			t.Fatalf("removed item (%v) does not match the expected item (%v)", removedItem, expectedItems[0])
+		}
+		expectedItems = expectedItems[1:]
+
+		checkArrayQueueItems(t, queue, expectedItems)
+	}
+}
+
+func TestArrayQueueGrowAndShrink(t *testing.T) {
+	queue := arrayqueue.New[int]()
+	expectedItems := make([]int, 0)
+
+	for round := range 3 {
+		for item := range 1000 {
+			queue.Add(round*1000 + item)
+			expectedItems = append(expectedItems, round*1000+item)
+		}
+		checkArrayQueueItems(t, queue, expectedItems)
+
+		for range 990 {
+			removedItem, _ := queue.Remove()
+			if removedItem != expectedItems[0] {
+				t.Fatalf("removed item (%v) does not match the expected item (%v)", removedItem, expectedItems[0])
+			}
+			expectedItems = expectedItems[1:]
+		}
+		checkArrayQueueItems(t, queue, expectedItems)
+	}
+
+	for len(expectedItems) > 0 {
+		removedItem, _ := queue.Remove()
+		if removedItem != expectedItems[0] {
+			t.Fatalf("removed item (%v) does not match the expected item (%v)", removedItem, expectedItems[0])
+		}
+		expectedItems = expectedItems[1:]
+	}
+	checkArrayQueueItems(t, queue, expectedItems)
+
+	if _, err := queue.Remove(); err == nil {
+		t.Errorf("did not encounter error when removing from empty queue")
+	}
+}
+
+func TestArrayQueueRandomOperations(t *testing.T) {
+	queue := arrayqueue.New[int]()
+	expectedItems := make([]int, 0)
+
+	for item := range 5000 {
+		if rand.Intn(3) > 0 {
+			queue.Add(item)
+			expectedItems = append(expectedItems, item)
+		} else if len(expectedItems) > 0 {
+			removedItem, _ := queue.Remove()
+			if removedItem != expectedItems[0] {
+				t.Fatalf("removed item (%v) does not match the expected item (%v)", removedItem, expectedItems[0])
+			}
+			expectedItems = expectedItems[1:]
+		}
+
+		peekItem, err := queue.Peek()
+		if len(expectedItems) > 0 && (err != nil || peekItem != expectedItems[0]) {
+			t.Fatalf("found peek item (%v, %v) does not match the expected item (%v)", peekItem, err, expectedItems[0])
+		}
+	}
+	checkArrayQueueItems(t, queue, expectedItems)
+
+	arrayqueue.ForwardMap(queue, func(item int) int { return -item })
+	for index := range expectedItems {
+		expectedItems[index] = -expectedItems[index]
+	}
+	checkArrayQueueItems(t, queue, expectedItems)
+}
+
+func TestArrayQueueRemoveAllWrapAround(t *testing.T) {
+	// Offset the head so the items wrap around the end of the backing array
+	queue := arrayqueue.New[int]()
+	for item := range 6 {
+		queue.Add(-1 - item)
+	}
+	for range 6 {
+		queue.Remove()
+	}
+	for item := range 8 {
+		queue.Add(item)
+	}
+
+	queue.RemoveAll(func(item int) bool { return item%2 == 0 })
+	checkArrayQueueItems(t, queue, []int{1, 3, 5, 7})
+
+	item, _ := queue.RemoveFirst(func(item int) bool {
+		return item == 3
+	})
+	if item != 3 {
+		t.Errorf("removed item (%v) does not match expected item (%v)", item, 3)
+	}
+	checkArrayQueueItems(t, queue, []int{1, 5, 7})
+
+	if _, err := queue.RemoveFirst(func(item int) bool { return item == 3 }); !errors.Is(err, dsa_error.ErrorItemNotFound) {
+		t.Errorf("expected ErrorItemNotFound when no item matches, found (%v)", err)
+	}
+}
+
+func TestArrayQueueRemoveAllShrinks(t *testing.T) {
+	queue := arrayqueue.New[int]()
+	for item := range 1000 {
+		queue.Add(item)
+	}
+
+	queue.Retain(func(item int) bool {
+		return item < 3
+	})
+	checkArrayQueueItems(t, queue, []int{0, 1, 2})
+
+	// The queue must keep working normally after a large shrink
+	for item := 3; item < 100; item += 1 {
+		queue.Add(item)
+	}
+	for expected := range 100 {
+		item, _ := queue.Remove()
+		if item != expected {
+			t.Fatalf("found item (%v) does not match expected item (%v)", item, expected)
+		}
+	}
+}
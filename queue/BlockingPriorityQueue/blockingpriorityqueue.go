@@ -0,0 +1,254 @@
+package blockingpriorityqueue
+
+import (
+	"context"
+	"sync"
+
+	priorityqueue "github.com/hmcalister/Go-DSA/queue/PriorityQueue"
+	comparator "github.com/hmcalister/Go-DSA/utils/Comparator"
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
+)
+
+// Implement a concurrency safe, blocking priority queue.
+//
+// This queue wraps github.com/hmcalister/Go-DSA/queue/PriorityQueue and guards it with a mutex.
+// Like PriorityQueue, lower priority values are put at the front of the queue.
+//
+// Take blocks until an item is available, and Offer blocks while the queue is at capacity.
+// Both accept a context, so waiting can be abandoned by cancelling the context.
+// Closing the queue wakes all waiting goroutines.
+type BlockingPriorityQueue[T any] struct {
+	mutex     sync.Mutex
+	queueData *priorityqueue.PriorityQueue[T]
+
+	// The maximum number of items in the queue. Zero means the queue is unbounded, see NewUnbounded.
+	capacity int
+
+	closed bool
+
+	// Closed (and replaced) whenever the state of the queue changes, waking all waiting goroutines.
+	//
+	// Unlike sync.Cond, waiting on a channel can be combined with a context in a select.
+	stateChanged chan struct{}
+}
+
+// Create a new blocking priority queue.
+//
+// The capacity is the maximum number of items held by the queue, after which Offer blocks.
+// For a queue without a capacity, use NewUnbounded.
+//
+// The comparatorFunction allows for items in the queue to be compared based on priority.
+// Remember that lower priority values are pushed to the front of the queue.
+//
+// Returns a dsa_error.ErrorInvalidCapacity if the capacity is not positive.
+func New[T any](capacity int, comparatorFunction comparator.ComparatorFunction[T]) (*BlockingPriorityQueue[T], error) {
+	if capacity <= 0 {
+		return nil, dsa_error.ErrorInvalidCapacity
+	}
+
+	return &BlockingPriorityQueue[T]{
+		queueData:    priorityqueue.New(comparatorFunction),
+		capacity:     capacity,
+		closed:       false,
+		stateChanged: make(chan struct{}),
+	}, nil
+}
+
+// Create a new blocking priority queue with no capacity, for which Offer never blocks.
+//
+// See New for details on the comparatorFunction.
+func NewUnbounded[T any](comparatorFunction comparator.ComparatorFunction[T]) *BlockingPriorityQueue[T] {
+	return &BlockingPriorityQueue[T]{
+		queueData:    priorityqueue.New(comparatorFunction),
+		capacity:     0,
+		closed:       false,
+		stateChanged: make(chan struct{}),
+	}
+}
+
+// Wake all goroutines waiting on a change of state.
+//
+// Must be called while holding the mutex.
+func (queue *BlockingPriorityQueue[T]) broadcast() {
+	close(queue.stateChanged)
+	queue.stateChanged = make(chan struct{})
+}
+
+// Determine if the queue is at capacity.
+//
+// Must be called while holding the mutex.
+func (queue *BlockingPriorityQueue[T]) isFull() bool {
+	return queue.capacity > 0 && queue.queueData.Size() >= queue.capacity
+}
+
+// ----------------------------------------------------------------------------
+// Get Methods
+
+// Peek at the front item in the queue without blocking.
+//
+// Returns a dsa_error.ErrorDataStructureEmpty error if the queue is empty.
+func (queue *BlockingPriorityQueue[T]) Peek() (T, error) {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	return queue.queueData.Peek()
+}
+
+// Get all items from the queue. This method allocates an array of length equal to the number of items.
+//
+// BEWARE: The items are not in priority order!
+func (queue *BlockingPriorityQueue[T]) Items() []T {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	return queue.queueData.Items()
+}
+
+// Get the size of the queue, the number of items in the queue.
+//
+// Since other goroutines may modify the queue, the size may be out of date as soon as it is returned.
+func (queue *BlockingPriorityQueue[T]) Size() int {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	return queue.queueData.Size()
+}
+
+// Get the capacity of the queue. A capacity of zero means the queue is unbounded.
+func (queue *BlockingPriorityQueue[T]) Capacity() int {
+	return queue.capacity
+}
+
+// Determine if the queue has been closed.
+func (queue *BlockingPriorityQueue[T]) IsClosed() bool {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	return queue.closed
+}
+
+// ----------------------------------------------------------------------------
+// Add Methods
+
+// Enqueue an item, blocking while the queue is at capacity.
+//
+// Returns dsa_error.ErrorQueueClosed if the queue is (or becomes) closed before the item is added,
+// or the context error if the context is cancelled before the item is added.
+// In either case the item is not added to the queue.
+func (queue *BlockingPriorityQueue[T]) Offer(ctx context.Context, item T) error {
+	queue.mutex.Lock()
+	for {
+		if queue.closed {
+			queue.mutex.Unlock()
+			return dsa_error.ErrorQueueClosed
+		}
+		if !queue.isFull() {
+			break
+		}
+
+		stateChanged := queue.stateChanged
+		queue.mutex.Unlock()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-stateChanged:
+		}
+		queue.mutex.Lock()
+	}
+
+	queue.queueData.Add(item)
+	queue.broadcast()
+	queue.mutex.Unlock()
+	return nil
+}
+
+// Enqueue an item without blocking.
+//
+// Returns dsa_error.ErrorQueueClosed if the queue is closed, or dsa_error.ErrorDataStructureFull if the queue is at capacity.
+func (queue *BlockingPriorityQueue[T]) TryOffer(item T) error {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	if queue.closed {
+		return dsa_error.ErrorQueueClosed
+	}
+	if queue.isFull() {
+		return dsa_error.ErrorDataStructureFull
+	}
+
+	queue.queueData.Add(item)
+	queue.broadcast()
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Remove Methods
+
+// Dequeue the front item, blocking until an item is available.
+//
+// Once the queue is closed, any remaining items may still be taken.
+// Returns dsa_error.ErrorQueueClosed if the queue is closed and empty,
+// or the context error if the context is cancelled before an item is available.
+func (queue *BlockingPriorityQueue[T]) Take(ctx context.Context) (T, error) {
+	queue.mutex.Lock()
+	for queue.queueData.Size() == 0 {
+		if queue.closed {
+			queue.mutex.Unlock()
+			return *new(T), dsa_error.ErrorQueueClosed
+		}
+
+		stateChanged := queue.stateChanged
+		queue.mutex.Unlock()
+		select {
+		case <-ctx.Done():
+			return *new(T), ctx.Err()
+		case <-stateChanged:
+		}
+		queue.mutex.Lock()
+	}
+
+	// We hold the mutex and know the queue is not empty, so we can ignore the error
+	item, _ := queue.queueData.Remove()
+	queue.broadcast()
+	queue.mutex.Unlock()
+	return item, nil
+}
+
+// Dequeue the front item without blocking.
+//
+// Returns dsa_error.ErrorQueueClosed if the queue is closed and empty, or dsa_error.ErrorDataStructureEmpty if the queue is empty.
+func (queue *BlockingPriorityQueue[T]) TryTake() (T, error) {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	if queue.queueData.Size() == 0 {
+		if queue.closed {
+			return *new(T), dsa_error.ErrorQueueClosed
+		}
+		return *new(T), dsa_error.ErrorDataStructureEmpty
+	}
+
+	item, _ := queue.queueData.Remove()
+	queue.broadcast()
+	return item, nil
+}
+
+// ----------------------------------------------------------------------------
+// Close Methods
+
+// Close the queue, waking all waiting goroutines.
+//
+// After closing, Offer and TryOffer return dsa_error.ErrorQueueClosed.
+// Take and TryTake continue to return any remaining items, then return dsa_error.ErrorQueueClosed once the queue is empty.
+//
+// Closing an already closed queue has no effect.
+func (queue *BlockingPriorityQueue[T]) Close() {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	if queue.closed {
+		return
+	}
+	queue.closed = true
+	queue.broadcast()
+}
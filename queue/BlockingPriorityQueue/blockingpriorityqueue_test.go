@@ -0,0 +1,240 @@
+package blockingpriorityqueue_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	blockingpriorityqueue "github.com/hmcalister/Go-DSA/queue/BlockingPriorityQueue"
+	comparator "github.com/hmcalister/Go-DSA/utils/Comparator"
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
+)
+
+func TestBlockingPriorityQueueInit(t *testing.T) {
+	t.Run("blocking priority queue int", func(t *testing.T) {
+		blockingpriorityqueue.NewUnbounded[int](comparator.DefaultIntegerComparator)
+	})
+	t.Run("blocking priority queue float", func(t *testing.T) {
+		blockingpriorityqueue.New[float64](10, comparator.DefaultFloat64Comparator)
+	})
+	t.Run("blocking priority queue string", func(t *testing.T) {
+		blockingpriorityqueue.New[string](10, comparator.DefaultStringComparator)
+	})
+	t.Run("blocking priority queue invalid capacity", func(t *testing.T) {
+		_, err := blockingpriorityqueue.New[int](0, comparator.DefaultIntegerComparator)
+		if !errors.Is(err, dsa_error.ErrorInvalidCapacity) {
+			t.Errorf("did not encounter expected error (%v) when creating queue with invalid capacity, found %v", dsa_error.ErrorInvalidCapacity, err)
+		}
+	})
+}
+
+func TestBlockingPriorityQueuePriorityOrder(t *testing.T) {
+	queue := blockingpriorityqueue.NewUnbounded[int](comparator.DefaultIntegerComparator)
+	items := []int{5, 3, 9, 1, 7}
+	for _, item := range items {
+		if err := queue.Offer(context.Background(), item); err != nil {
+			t.Errorf("encountered error (%v) when offering to unbounded queue", err)
+		}
+	}
+
+	slices.Sort(items)
+	for _, expectedItem := range items {
+		item, err := queue.Take(context.Background())
+		if err != nil {
+			t.Errorf("encountered error (%v) when taking from non-empty queue", err)
+		}
+		if item != expectedItem {
+			t.Errorf("taken item (%v) does not match expected item (%v)", item, expectedItem)
+		}
+	}
+}
+
+func TestBlockingPriorityQueueTryMethods(t *testing.T) {
+	queue, _ := blockingpriorityqueue.New[int](1, comparator.DefaultIntegerComparator)
+
+	if _, err := queue.TryTake(); !errors.Is(err, dsa_error.ErrorDataStructureEmpty) {
+		t.Errorf("did not encounter expected error (%v) when taking from empty queue, found %v", dsa_error.ErrorDataStructureEmpty, err)
+	}
+	if err := queue.TryOffer(1); err != nil {
+		t.Errorf("encountered error (%v) when offering to non-full queue", err)
+	}
+	if err := queue.TryOffer(2); !errors.Is(err, dsa_error.ErrorDataStructureFull) {
+		t.Errorf("did not encounter expected error (%v) when offering to full queue, found %v", dsa_error.ErrorDataStructureFull, err)
+	}
+	if item, err := queue.TryTake(); err != nil || item != 1 {
+		t.Errorf("expected to take item 1, found (%v, %v)", item, err)
+	}
+}
+
+func TestBlockingPriorityQueueTakeBlocksUntilOffer(t *testing.T) {
+	queue := blockingpriorityqueue.NewUnbounded[int](comparator.DefaultIntegerComparator)
+
+	result := make(chan int)
+	go func() {
+		item, err := queue.Take(context.Background())
+		if err != nil {
+			t.Errorf("encountered error (%v) when taking from queue", err)
+		}
+		result <- item
+	}()
+
+	select {
+	case item := <-result:
+		t.Fatalf("take returned item %v from empty queue", item)
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	queue.Offer(context.Background(), 42)
+	if item := <-result; item != 42 {
+		t.Errorf("taken item (%v) does not match expected item (%v)", item, 42)
+	}
+}
+
+func TestBlockingPriorityQueueOfferBlocksAtCapacity(t *testing.T) {
+	queue, _ := blockingpriorityqueue.New[int](1, comparator.DefaultIntegerComparator)
+	queue.Offer(context.Background(), 1)
+
+	result := make(chan error)
+	go func() {
+		result <- queue.Offer(context.Background(), 2)
+	}()
+
+	select {
+	case err := <-result:
+		t.Fatalf("offer returned (%v) on full queue", err)
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	queue.Take(context.Background())
+	if err := <-result; err != nil {
+		t.Errorf("encountered error (%v) when offering to queue", err)
+	}
+	if queue.Size() != 1 {
+		t.Errorf("queue size (%v) does not match expected size (%v)", queue.Size(), 1)
+	}
+}
+
+func TestBlockingPriorityQueueContextCancel(t *testing.T) {
+	queue, _ := blockingpriorityqueue.New[int](1, comparator.DefaultIntegerComparator)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := queue.Take(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("did not encounter expected error (%v) when taking from empty queue, found %v", context.DeadlineExceeded, err)
+	}
+
+	queue.Offer(context.Background(), 1)
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	if err := queue.Offer(ctx, 2); !errors.Is(err, context.Canceled) {
+		t.Errorf("did not encounter expected error (%v) when offering to full queue, found %v", context.Canceled, err)
+	}
+	if queue.Size() != 1 {
+		t.Errorf("queue size (%v) does not match expected size (%v)", queue.Size(), 1)
+	}
+}
+
+func TestBlockingPriorityQueueCloseWakesWaiters(t *testing.T) {
+	queue := blockingpriorityqueue.NewUnbounded[int](comparator.DefaultIntegerComparator)
+
+	numWaiters := 8
+	var waitGroup sync.WaitGroup
+	errs := make(chan error, numWaiters)
+	for range numWaiters {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			_, err := queue.Take(context.Background())
+			errs <- err
+		}()
+	}
+
+	time.Sleep(10 * time.Millisecond)
+	queue.Close()
+	waitGroup.Wait()
+	close(errs)
+
+	for err := range errs {
+		if !errors.Is(err, dsa_error.ErrorQueueClosed) {
+			t.Errorf("did not encounter expected error (%v) after close, found %v", dsa_error.ErrorQueueClosed, err)
+		}
+	}
+
+	if err := queue.Offer(context.Background(), 1); !errors.Is(err, dsa_error.ErrorQueueClosed) {
+		t.Errorf("did not encounter expected error (%v) when offering to closed queue, found %v", dsa_error.ErrorQueueClosed, err)
+	}
+}
+
+func TestBlockingPriorityQueueCloseDrainsRemaining(t *testing.T) {
+	queue := blockingpriorityqueue.NewUnbounded[int](comparator.DefaultIntegerComparator)
+	queue.Offer(context.Background(), 2)
+	queue.Offer(context.Background(), 1)
+	queue.Close()
+
+	for _, expectedItem := range []int{1, 2} {
+		item, err := queue.Take(context.Background())
+		if err != nil || item != expectedItem {
+			t.Errorf("expected to take item %v from closed queue, found (%v, %v)", expectedItem, item, err)
+		}
+	}
+
+	if _, err := queue.Take(context.Background()); !errors.Is(err, dsa_error.ErrorQueueClosed) {
+		t.Errorf("did not encounter expected error (%v) when taking from closed empty queue, found %v", dsa_error.ErrorQueueClosed, err)
+	}
+}
+
+func TestBlockingPriorityQueueConcurrentProducersConsumers(t *testing.T) {
+	queue, _ := blockingpriorityqueue.New[int](4, comparator.DefaultIntegerComparator)
+
+	numProducers := 4
+	numItemsPerProducer := 250
+	var producers sync.WaitGroup
+	for producer := range numProducers {
+		producers.Add(1)
+		go func() {
+			defer producers.Done()
+			for i := range numItemsPerProducer {
+				if err := queue.Offer(context.Background(), producer*numItemsPerProducer+i); err != nil {
+					t.Errorf("encountered error (%v) when offering to queue", err)
+				}
+			}
+		}()
+	}
+
+	numConsumers := 4
+	var consumers sync.WaitGroup
+	var takenMutex sync.Mutex
+	taken := make([]int, 0)
+	for range numConsumers {
+		consumers.Add(1)
+		go func() {
+			defer consumers.Done()
+			for {
+				item, err := queue.Take(context.Background())
+				if err != nil {
+					return
+				}
+				takenMutex.Lock()
+				taken = append(taken, item)
+				takenMutex.Unlock()
+			}
+		}()
+	}
+
+	producers.Wait()
+	queue.Close()
+	consumers.Wait()
+
+	slices.Sort(taken)
+	if len(taken) != numProducers*numItemsPerProducer {
+		t.Fatalf("number of taken items (%v) does not match expected number (%v)", len(taken), numProducers*numItemsPerProducer)
+	}
+	for i, item := range taken {
+		if i != item {
+			t.Fatalf("taken items are missing item %v", i)
+		}
+	}
+}
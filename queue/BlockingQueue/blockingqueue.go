@@ -0,0 +1,323 @@
+package blockingqueue
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	arrayqueue "github.com/hmcalister/Go-DSA/queue/ArrayQueue"
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
+)
+
+// Implement a concurrency safe, bounded, blocking queue for producer / consumer pipelines.
+//
+// This queue wraps github.com/hmcalister/Go-DSA/queue/ArrayQueue and guards it with a mutex.
+// Items are taken in the order they were put (first in, first out).
+//
+// Put blocks while the queue is at capacity, and Take blocks until an item is available.
+// Both accept a context, so waiting can be abandoned by cancelling the context.
+// Closing the queue prevents new items from being put, while letting consumers take any remaining items.
+type BlockingQueue[T any] struct {
+	mutex     sync.Mutex
+	queueData *arrayqueue.ArrayQueue[T]
+
+	// The maximum number of items in the queue. Zero means the queue is unbounded, see NewUnbounded.
+	capacity int
+
+	closed bool
+
+	// Closed (and replaced) whenever the state of the queue changes, waking all waiting goroutines.
+	//
+	// Unlike sync.Cond, waiting on a channel can be combined with a context in a select.
+	stateChanged chan struct{}
+
+	// The channel adaptor, created on the first call to Chan()
+	channelOnce sync.Once
+	channel     chan T
+}
+
+// Create a new blocking queue.
+//
+// The capacity is the maximum number of items held by the queue, after which Put blocks.
+// For a queue without a capacity, use NewUnbounded.
+//
+// Returns a dsa_error.ErrorInvalidCapacity if the capacity is not positive.
+func New[T any](capacity int) (*BlockingQueue[T], error) {
+	if capacity <= 0 {
+		return nil, dsa_error.ErrorInvalidCapacity
+	}
+
+	return &BlockingQueue[T]{
+		queueData:    arrayqueue.New[T](),
+		capacity:     capacity,
+		closed:       false,
+		stateChanged: make(chan struct{}),
+	}, nil
+}
+
+// Create a new blocking queue with no capacity, for which Put never blocks.
+func NewUnbounded[T any]() *BlockingQueue[T] {
+	return &BlockingQueue[T]{
+		queueData:    arrayqueue.New[T](),
+		capacity:     0,
+		closed:       false,
+		stateChanged: make(chan struct{}),
+	}
+}
+
+// Wake all goroutines waiting on a change of state.
+//
+// Must be called while holding the mutex.
+func (queue *BlockingQueue[T]) broadcast() {
+	close(queue.stateChanged)
+	queue.stateChanged = make(chan struct{})
+}
+
+// Determine if the queue is at capacity.
+//
+// Must be called while holding the mutex.
+func (queue *BlockingQueue[T]) isFull() bool {
+	return queue.capacity > 0 && queue.queueData.Size() >= queue.capacity
+}
+
+// ----------------------------------------------------------------------------
+// Get Methods
+
+// Peek at the front item in the queue without blocking.
+//
+// Returns a dsa_error.ErrorDataStructureEmpty error if the queue is empty.
+func (queue *BlockingQueue[T]) Peek() (T, error) {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	return queue.queueData.Peek()
+}
+
+// Get all items from the queue, front first. This method allocates an array of length equal to the number of items.
+func (queue *BlockingQueue[T]) Items() []T {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	return queue.queueData.Items()
+}
+
+// Get the size of the queue, the number of items in the queue.
+//
+// Since other goroutines may modify the queue, the size may be out of date as soon as it is returned.
+func (queue *BlockingQueue[T]) Size() int {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	return queue.queueData.Size()
+}
+
+// Get the capacity of the queue. A capacity of zero means the queue is unbounded.
+func (queue *BlockingQueue[T]) Capacity() int {
+	return queue.capacity
+}
+
+// Determine if the queue has been closed.
+func (queue *BlockingQueue[T]) IsClosed() bool {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	return queue.closed
+}
+
+// ----------------------------------------------------------------------------
+// Add Methods
+
+// Put an item at the back of the queue, blocking while the queue is at capacity.
+//
+// Returns dsa_error.ErrorQueueClosed if the queue is (or becomes) closed before the item is added,
+// or the context error if the context is cancelled before the item is added.
+// In either case the item is not added to the queue.
+func (queue *BlockingQueue[T]) Put(ctx context.Context, item T) error {
+	queue.mutex.Lock()
+	for {
+		if queue.closed {
+			queue.mutex.Unlock()
+			return dsa_error.ErrorQueueClosed
+		}
+		if !queue.isFull() {
+			break
+		}
+
+		stateChanged := queue.stateChanged
+		queue.mutex.Unlock()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-stateChanged:
+		}
+		queue.mutex.Lock()
+	}
+
+	queue.queueData.Add(item)
+	queue.broadcast()
+	queue.mutex.Unlock()
+	return nil
+}
+
+// Put an item at the back of the queue, blocking for at most the given timeout.
+//
+// Returns context.DeadlineExceeded if the timeout passes before the item is added. See Put.
+func (queue *BlockingQueue[T]) PutTimeout(item T, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	return queue.Put(ctx, item)
+}
+
+// Put an item at the back of the queue without blocking.
+//
+// Returns dsa_error.ErrorQueueClosed if the queue is closed, or dsa_error.ErrorDataStructureFull if the queue is at capacity.
+func (queue *BlockingQueue[T]) TryPut(item T) error {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	if queue.closed {
+		return dsa_error.ErrorQueueClosed
+	}
+	if queue.isFull() {
+		return dsa_error.ErrorDataStructureFull
+	}
+
+	queue.queueData.Add(item)
+	queue.broadcast()
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Remove Methods
+
+// Take the front item of the queue, blocking until an item is available.
+//
+// Once the queue is closed, any remaining items may still be taken.
+// Returns dsa_error.ErrorQueueClosed if the queue is closed and empty,
+// or the context error if the context is cancelled before an item is available.
+func (queue *BlockingQueue[T]) Take(ctx context.Context) (T, error) {
+	queue.mutex.Lock()
+	for queue.queueData.Size() == 0 {
+		if queue.closed {
+			queue.mutex.Unlock()
+			return *new(T), dsa_error.ErrorQueueClosed
+		}
+
+		stateChanged := queue.stateChanged
+		queue.mutex.Unlock()
+		select {
+		case <-ctx.Done():
+			return *new(T), ctx.Err()
+		case <-stateChanged:
+		}
+		queue.mutex.Lock()
+	}
+
+	// We hold the mutex and know the queue is not empty, so we can ignore the error
+	item, _ := queue.queueData.Remove()
+	queue.broadcast()
+	queue.mutex.Unlock()
+	return item, nil
+}
+
+// Take the front item of the queue, blocking for at most the given timeout.
+//
+// Returns context.DeadlineExceeded if the timeout passes before an item is available. See Take.
+func (queue *BlockingQueue[T]) TakeTimeout(timeout time.Duration) (T, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	return queue.Take(ctx)
+}
+
+// Take the front item of the queue without blocking.
+//
+// Returns dsa_error.ErrorQueueClosed if the queue is closed and empty, or dsa_error.ErrorDataStructureEmpty if the queue is empty.
+func (queue *BlockingQueue[T]) TryTake() (T, error) {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	if queue.queueData.Size() == 0 {
+		if queue.closed {
+			return *new(T), dsa_error.ErrorQueueClosed
+		}
+		return *new(T), dsa_error.ErrorDataStructureEmpty
+	}
+
+	item, _ := queue.queueData.Remove()
+	queue.broadcast()
+	return item, nil
+}
+
+// Take up to maxItems items from the front of the queue without blocking, appending them to items.
+// If maxItems is negative, all items in the queue are taken.
+//
+// Returns the extended slice. The number of items taken is the difference in length.
+func (queue *BlockingQueue[T]) DrainTo(items []T, maxItems int) []T {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	numItems := queue.queueData.Size()
+	if maxItems >= 0 {
+		numItems = min(numItems, maxItems)
+	}
+	for range numItems {
+		item, _ := queue.queueData.Remove()
+		items = append(items, item)
+	}
+
+	if numItems > 0 {
+		queue.broadcast()
+	}
+	return items
+}
+
+// ----------------------------------------------------------------------------
+// Channel Methods
+
+// Get a channel that receives the items of the queue, front first.
+//
+// The first call starts a goroutine that takes items from the queue and sends them on the channel.
+// Later calls return the same channel. The channel is closed once the queue is closed and all items have been taken.
+//
+// BEWARE: The goroutine holds one taken item while waiting for it to be received. Hence, items taken by the
+// goroutine are no longer counted by Size(), and mixing Chan() with Take() may see items slightly out of order.
+// If the channel is abandoned, close the queue and drain the channel so that the goroutine can exit.
+func (queue *BlockingQueue[T]) Chan() <-chan T {
+	queue.channelOnce.Do(func() {
+		queue.channel = make(chan T)
+		go func() {
+			defer close(queue.channel)
+			for {
+				item, err := queue.Take(context.Background())
+				if err != nil {
+					return
+				}
+				queue.channel <- item
+			}
+		}()
+	})
+	return queue.channel
+}
+
+// ----------------------------------------------------------------------------
+// Close Methods
+
+// Close the queue, waking all waiting goroutines.
+//
+// After closing, Put and TryPut return dsa_error.ErrorQueueClosed.
+// Take, TryTake, DrainTo and Chan continue to return any remaining items.
+// Once the queue is empty, Take and TryTake return dsa_error.ErrorQueueClosed.
+//
+// Closing an already closed queue has no effect.
+func (queue *BlockingQueue[T]) Close() {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	if queue.closed {
+		return
+	}
+	queue.closed = true
+	queue.broadcast()
+}
@@ -0,0 +1,108 @@
+package blockingqueue_test
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"slices"
+	"sync"
+	"testing"
+
+	blockingqueue "github.com/hmcalister/Go-DSA/queue/BlockingQueue"
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
+)
+
+// Run many producers and consumers against a small queue, checking every item is taken exactly once
+// and that each producer's items are taken in the order they were put.
+//
+// Run with `go test -race` to check for data races.
+func TestBlockingQueueStress(t *testing.T) {
+	const numProducers = 8
+	const numConsumers = 8
+	const numItemsPerProducer = 2000
+
+	queue, _ := blockingqueue.New[[2]int](4)
+
+	var producers sync.WaitGroup
+	for producer := range numProducers {
+		producers.Add(1)
+		go func() {
+			defer producers.Done()
+			for i := range numItemsPerProducer {
+				var err error
+				if i%2 == 0 {
+					err = queue.Put(context.Background(), [2]int{producer, i})
+				} else {
+					// Mix in non-blocking puts, retrying until there is room
+					for err = queue.TryPut([2]int{producer, i}); errors.Is(err, dsa_error.ErrorDataStructureFull); err = queue.TryPut([2]int{producer, i}) {
+						runtime.Gosched()
+					}
+				}
+				if err != nil {
+					t.Errorf("encountered error (%v) when putting to queue", err)
+				}
+			}
+		}()
+	}
+
+	var consumers sync.WaitGroup
+	takenItems := make([][][2]int, numConsumers)
+	for consumer := range numConsumers {
+		consumers.Add(1)
+		go func() {
+			defer consumers.Done()
+			for {
+				var item [2]int
+				var err error
+				if consumer%2 == 0 {
+					item, err = queue.Take(context.Background())
+				} else {
+					drained := queue.DrainTo(nil, 3)
+					if len(drained) == 0 {
+						item, err = queue.Take(context.Background())
+					} else {
+						takenItems[consumer] = append(takenItems[consumer], drained...)
+						continue
+					}
+				}
+				if err != nil {
+					return
+				}
+				takenItems[consumer] = append(takenItems[consumer], item)
+			}
+		}()
+	}
+
+	producers.Wait()
+	queue.Close()
+	consumers.Wait()
+
+	// Each consumer must see the items of each producer in increasing order
+	seenItems := make([][]int, numProducers)
+	for consumer := range numConsumers {
+		lastSeen := make([]int, numProducers)
+		for i := range lastSeen {
+			lastSeen[i] = -1
+		}
+		for _, item := range takenItems[consumer] {
+			producer, index := item[0], item[1]
+			if index <= lastSeen[producer] {
+				t.Fatalf("consumer %v took item %v of producer %v after item %v", consumer, index, producer, lastSeen[producer])
+			}
+			lastSeen[producer] = index
+			seenItems[producer] = append(seenItems[producer], index)
+		}
+	}
+
+	for producer := range numProducers {
+		slices.Sort(seenItems[producer])
+		if len(seenItems[producer]) != numItemsPerProducer {
+			t.Fatalf("found %v items from producer %v, expected %v", len(seenItems[producer]), producer, numItemsPerProducer)
+		}
+		for i, index := range seenItems[producer] {
+			if i != index {
+				t.Fatalf("items of producer %v are missing item %v", producer, i)
+			}
+		}
+	}
+}
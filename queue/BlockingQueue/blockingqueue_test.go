@@ -0,0 +1,214 @@
+package blockingqueue_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	blockingqueue "github.com/hmcalister/Go-DSA/queue/BlockingQueue"
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
+)
+
+func TestBlockingQueueInit(t *testing.T) {
+	t.Run("blocking queue int", func(t *testing.T) {
+		blockingqueue.NewUnbounded[int]()
+	})
+	t.Run("blocking queue float", func(t *testing.T) {
+		blockingqueue.New[float64](10)
+	})
+	t.Run("blocking queue string", func(t *testing.T) {
+		blockingqueue.New[string](10)
+	})
+	t.Run("blocking queue invalid capacity", func(t *testing.T) {
+		_, err := blockingqueue.New[int](0)
+		if !errors.Is(err, dsa_error.ErrorInvalidCapacity) {
+			t.Errorf("did not encounter expected error (%v) when creating queue with invalid capacity, found %v", dsa_error.ErrorInvalidCapacity, err)
+		}
+	})
+}
+
+func TestBlockingQueueOrder(t *testing.T) {
+	queue := blockingqueue.NewUnbounded[int]()
+	items := []int{5, 3, 9, 1, 7}
+	for _, item := range items {
+		if err := queue.Put(context.Background(), item); err != nil {
+			t.Errorf("encountered error (%v) when putting to unbounded queue", err)
+		}
+	}
+
+	if peekItem, err := queue.Peek(); err != nil || peekItem != items[0] {
+		t.Errorf("expected to peek item %v, found (%v, %v)", items[0], peekItem, err)
+	}
+
+	for _, expectedItem := range items {
+		item, err := queue.Take(context.Background())
+		if err != nil || item != expectedItem {
+			t.Errorf("expected to take item %v, found (%v, %v)", expectedItem, item, err)
+		}
+	}
+}
+
+func TestBlockingQueueTryMethods(t *testing.T) {
+	queue, _ := blockingqueue.New[int](1)
+
+	if _, err := queue.TryTake(); !errors.Is(err, dsa_error.ErrorDataStructureEmpty) {
+		t.Errorf("did not encounter expected error (%v) when taking from empty queue, found %v", dsa_error.ErrorDataStructureEmpty, err)
+	}
+	if err := queue.TryPut(1); err != nil {
+		t.Errorf("encountered error (%v) when putting to non-full queue", err)
+	}
+	if err := queue.TryPut(2); !errors.Is(err, dsa_error.ErrorDataStructureFull) {
+		t.Errorf("did not encounter expected error (%v) when putting to full queue, found %v", dsa_error.ErrorDataStructureFull, err)
+	}
+	if item, err := queue.TryTake(); err != nil || item != 1 {
+		t.Errorf("expected to take item 1, found (%v, %v)", item, err)
+	}
+}
+
+func TestBlockingQueueTimeouts(t *testing.T) {
+	queue, _ := blockingqueue.New[int](1)
+
+	if _, err := queue.TakeTimeout(10 * time.Millisecond); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("did not encounter expected error (%v) when taking from empty queue, found %v", context.DeadlineExceeded, err)
+	}
+
+	queue.Put(context.Background(), 1)
+	if err := queue.PutTimeout(2, 10*time.Millisecond); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("did not encounter expected error (%v) when putting to full queue, found %v", context.DeadlineExceeded, err)
+	}
+
+	if item, err := queue.TakeTimeout(10 * time.Millisecond); err != nil || item != 1 {
+		t.Errorf("expected to take item 1, found (%v, %v)", item, err)
+	}
+}
+
+func TestBlockingQueueBlocking(t *testing.T) {
+	queue, _ := blockingqueue.New[int](1)
+
+	takeResult := make(chan int)
+	go func() {
+		item, _ := queue.Take(context.Background())
+		takeResult <- item
+	}()
+
+	select {
+	case item := <-takeResult:
+		t.Fatalf("take returned item %v from empty queue", item)
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	queue.Put(context.Background(), 1)
+	if item := <-takeResult; item != 1 {
+		t.Errorf("taken item (%v) does not match expected item (%v)", item, 1)
+	}
+
+	queue.Put(context.Background(), 2)
+	putResult := make(chan error)
+	go func() {
+		putResult <- queue.Put(context.Background(), 3)
+	}()
+
+	select {
+	case err := <-putResult:
+		t.Fatalf("put returned (%v) on full queue", err)
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	queue.Take(context.Background())
+	if err := <-putResult; err != nil {
+		t.Errorf("encountered error (%v) when putting to queue", err)
+	}
+}
+
+func TestBlockingQueueDrainTo(t *testing.T) {
+	queue := blockingqueue.NewUnbounded[int]()
+	for item := range 10 {
+		queue.Put(context.Background(), item)
+	}
+
+	items := queue.DrainTo([]int{-1}, 4)
+	expectedItems := []int{-1, 0, 1, 2, 3}
+	if !slices.Equal(expectedItems, items) {
+		t.Errorf("found items %v do not match expected items %v", items, expectedItems)
+	}
+
+	items = queue.DrainTo(nil, -1)
+	expectedItems = []int{4, 5, 6, 7, 8, 9}
+	if !slices.Equal(expectedItems, items) {
+		t.Errorf("found items %v do not match expected items %v", items, expectedItems)
+	}
+
+	if queue.Size() != 0 {
+		t.Errorf("queue size (%v) does not match expected size (%v)", queue.Size(), 0)
+	}
+}
+
+func TestBlockingQueueClose(t *testing.T) {
+	queue := blockingqueue.NewUnbounded[int]()
+	queue.Put(context.Background(), 1)
+	queue.Put(context.Background(), 2)
+	queue.Close()
+
+	if err := queue.Put(context.Background(), 3); !errors.Is(err, dsa_error.ErrorQueueClosed) {
+		t.Errorf("did not encounter expected error (%v) when putting to closed queue, found %v", dsa_error.ErrorQueueClosed, err)
+	}
+	if err := queue.TryPut(3); !errors.Is(err, dsa_error.ErrorQueueClosed) {
+		t.Errorf("did not encounter expected error (%v) when putting to closed queue, found %v", dsa_error.ErrorQueueClosed, err)
+	}
+
+	for _, expectedItem := range []int{1, 2} {
+		item, err := queue.Take(context.Background())
+		if err != nil || item != expectedItem {
+			t.Errorf("expected to take item %v from closed queue, found (%v, %v)", expectedItem, item, err)
+		}
+	}
+
+	if _, err := queue.Take(context.Background()); !errors.Is(err, dsa_error.ErrorQueueClosed) {
+		t.Errorf("did not encounter expected error (%v) when taking from closed empty queue, found %v", dsa_error.ErrorQueueClosed, err)
+	}
+	if _, err := queue.TryTake(); !errors.Is(err, dsa_error.ErrorQueueClosed) {
+		t.Errorf("did not encounter expected error (%v) when taking from closed empty queue, found %v", dsa_error.ErrorQueueClosed, err)
+	}
+}
+
+func TestBlockingQueueCloseWakesPutters(t *testing.T) {
+	queue, _ := blockingqueue.New[int](1)
+	queue.Put(context.Background(), 1)
+
+	putResult := make(chan error)
+	go func() {
+		putResult <- queue.Put(context.Background(), 2)
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	queue.Close()
+	if err := <-putResult; !errors.Is(err, dsa_error.ErrorQueueClosed) {
+		t.Errorf("did not encounter expected error (%v) after close, found %v", dsa_error.ErrorQueueClosed, err)
+	}
+}
+
+func TestBlockingQueueChan(t *testing.T) {
+	queue, _ := blockingqueue.New[int](2)
+	go func() {
+		for item := range 10 {
+			queue.Put(context.Background(), item)
+		}
+		queue.Close()
+	}()
+
+	items := make([]int, 0)
+	for item := range queue.Chan() {
+		items = append(items, item)
+	}
+
+	expectedItems := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	if !slices.Equal(expectedItems, items) {
+		t.Errorf("found items %v do not match expected items %v", items, expectedItems)
+	}
+
+	if queue.Chan() != queue.Chan() {
+		t.Errorf("repeated calls to Chan returned different channels")
+	}
+}
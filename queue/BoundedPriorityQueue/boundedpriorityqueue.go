@@ -0,0 +1,289 @@
+package boundedpriorityqueue
+
+import (
+	"iter"
+	"slices"
+
+	minmaxheap "github.com/hmcalister/Go-DSA/heap/MinMaxHeap"
+	comparator "github.com/hmcalister/Go-DSA/utils/Comparator"
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
+)
+
+// Determines the behavior of a BoundedPriorityQueue when an item is added to a full queue.
+type FullPolicy int
+
+const (
+	// When the queue is full, evict the worst item (the item at the back of the queue) to make room for a better item.
+	// If the new item is no better than the current worst item, the new item is evicted instead.
+	EvictWorst FullPolicy = iota
+
+	// When the queue is full, reject the new item with a dsa_error.ErrorDataStructureFull.
+	RejectNew FullPolicy = iota
+)
+
+// Implement a capacity bounded priority queue.
+//
+// A bounded priority queue retains at most `capacity` items, always keeping the items with the best priority.
+// This is useful for tracking the top-K items of a stream, for example the K largest latencies seen in a window.
+//
+// Like github.com/hmcalister/Go-DSA/queue/PriorityQueue, lower priority values are put at the front of the queue.
+// Hence, the worst item (the first to be evicted) is the item with the largest priority value.
+// To keep the K *largest* items of a stream, simply flip the logic in the comparator passed to the constructor.
+//
+// This implementation uses a min-max heap (github.com/hmcalister/Go-DSA/heap/MinMaxHeap) so that both
+// the best and worst items can be found in constant time, and removed in logarithmic time.
+type BoundedPriorityQueue[T any] struct {
+	queueData          *minmaxheap.MinMaxHeap[T]
+	comparatorFunction comparator.ComparatorFunction[T]
+	capacity           int
+	policy             FullPolicy
+
+	// Called with each item that is evicted from the queue. May be nil.
+	evictionCallback func(item T)
+}
+
+// Create a new bounded priority queue holding at most capacity items.
+//
+// The policy determines what happens when an item is added to a full queue, see FullPolicy.
+//
+// The comparatorFunction allows for items in the queue to be compared based on priority.
+// Remember that lower priority values are pushed to the front of the queue, and the largest priority values are evicted first.
+//
+// Returns a dsa_error.ErrorInvalidCapacity if the capacity is not positive.
+func New[T any](capacity int, policy FullPolicy, comparatorFunction comparator.ComparatorFunction[T]) (*BoundedPriorityQueue[T], error) {
+	if capacity <= 0 {
+		return nil, dsa_error.ErrorInvalidCapacity
+	}
+
+	return &BoundedPriorityQueue[T]{
+		queueData:          minmaxheap.New(comparatorFunction),
+		comparatorFunction: comparatorFunction,
+		capacity:           capacity,
+		policy:             policy,
+		evictionCallback:   nil,
+	}, nil
+}
+
+// Set the function called with each item evicted from the queue, either by Add or Resize.
+//
+// Items removed explicitly, by Remove or RemoveWorst, are *not* passed to the callback.
+// Passing nil removes any existing callback.
+func (queue *BoundedPriorityQueue[T]) SetEvictionCallback(f func(item T)) {
+	queue.evictionCallback = f
+}
+
+// Report an evicted item to the callback, if one is set.
+func (queue *BoundedPriorityQueue[T]) evict(item T) {
+	if queue.evictionCallback != nil {
+		queue.evictionCallback(item)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Get Methods
+
+// Peek at the front (best) item in the queue.
+//
+// Returns a dsa_error.ErrorDataStructureEmpty error if the queue is empty.
+func (queue *BoundedPriorityQueue[T]) Peek() (T, error) {
+	return queue.queueData.PeekMin()
+}
+
+// Peek at the back (worst) item in the queue. This is the item that will be evicted next.
+//
+// Returns a dsa_error.ErrorDataStructureEmpty error if the queue is empty.
+func (queue *BoundedPriorityQueue[T]) PeekWorst() (T, error) {
+	return queue.queueData.PeekMax()
+}
+
+// Find the first item in a queue matching a predicate.
+//
+// Returns (item, nil) if the item is present, or (*new(T), dsa_error.ErrorItemNotFound) if the item is not present.
+func (queue *BoundedPriorityQueue[T]) Find(predicate func(item T) bool) (T, error) {
+	return queue.queueData.Find(predicate)
+}
+
+// Find all items in a queue matching a predicate.
+//
+// Returns all items from the queue that match the predicate.
+func (queue *BoundedPriorityQueue[T]) FindAll(predicate func(item T) bool) []T {
+	return queue.queueData.FindAll(predicate)
+}
+
+// Get all items from the queue. This method allocates an array of length equal to the number of items.
+//
+// The items are *not* in priority order. To get the items in priority order, use SortedItems().
+func (queue *BoundedPriorityQueue[T]) Items() []T {
+	return queue.queueData.Items()
+}
+
+// Get a snapshot of all items from the queue in priority order, front (best) item first.
+// This method allocates an array of length equal to the number of items.
+func (queue *BoundedPriorityQueue[T]) SortedItems() []T {
+	items := queue.queueData.Items()
+	slices.SortFunc(items, queue.comparatorFunction)
+	return items
+}
+
+// Get the size of the queue, the number of items in the queue.
+func (queue *BoundedPriorityQueue[T]) Size() int {
+	return queue.queueData.Size()
+}
+
+// Get the capacity of the queue, the maximum number of items the queue may hold.
+func (queue *BoundedPriorityQueue[T]) Capacity() int {
+	return queue.capacity
+}
+
+// Determine if the queue is full, i.e. if the size of the queue is equal to the capacity.
+func (queue *BoundedPriorityQueue[T]) IsFull() bool {
+	return queue.queueData.Size() >= queue.capacity
+}
+
+// ----------------------------------------------------------------------------
+// Add Methods
+
+// Add an item to the queue.
+//
+// If the queue is not full, the item is always added and (*new(T), false, nil) is returned.
+//
+// If the queue is full and the policy is RejectNew, the item is not added and (*new(T), false, dsa_error.ErrorDataStructureFull) is returned.
+//
+// If the queue is full and the policy is EvictWorst, exactly one item is evicted and returned as (evictedItem, true, nil).
+// If the new item has a better priority than the worst item in the queue, the worst item is evicted and the new item is added.
+// Otherwise, the new item is evicted immediately without being added.
+// In either case, the evicted item is also passed to the eviction callback (if set).
+func (queue *BoundedPriorityQueue[T]) Add(item T) (T, bool, error) {
+	if !queue.IsFull() {
+		queue.queueData.Add(item)
+		return *new(T), false, nil
+	}
+
+	if queue.policy == RejectNew {
+		return *new(T), false, dsa_error.ErrorDataStructureFull
+	}
+
+	// We know the queue is full, and hence not empty, so we can ignore the error
+	worstItem, _ := queue.queueData.PeekMax()
+	if queue.comparatorFunction(item, worstItem) >= 0 {
+		queue.evict(item)
+		return item, true, nil
+	}
+
+	queue.queueData.RemoveMax()
+	queue.queueData.Add(item)
+	queue.evict(worstItem)
+	return worstItem, true, nil
+}
+
+// ----------------------------------------------------------------------------
+// Remove Methods
+
+// Dequeue an item, removing the front (best) item of the queue.
+//
+// Returns a dsa_error.ErrorDataStructureEmpty if the queue is empty.
+func (queue *BoundedPriorityQueue[T]) Remove() (T, error) {
+	return queue.queueData.RemoveMin()
+}
+
+// Remove the back (worst) item of the queue.
+//
+// The removed item is *not* passed to the eviction callback.
+//
+// Returns a dsa_error.ErrorDataStructureEmpty if the queue is empty.
+func (queue *BoundedPriorityQueue[T]) RemoveWorst() (T, error) {
+	return queue.queueData.RemoveMax()
+}
+
+// ----------------------------------------------------------------------------
+// Capacity Methods
+
+// Change the capacity of the queue.
+//
+// If the new capacity is smaller than the current size of the queue, the worst items are evicted
+// until the queue fits. The evicted items are returned (worst item first) and passed to the eviction callback (if set).
+//
+// Returns a dsa_error.ErrorInvalidCapacity if the new capacity is not positive, in which case the queue is not changed.
+func (queue *BoundedPriorityQueue[T]) Resize(capacity int) ([]T, error) {
+	if capacity <= 0 {
+		return nil, dsa_error.ErrorInvalidCapacity
+	}
+
+	queue.capacity = capacity
+	evictedItems := make([]T, 0, max(0, queue.queueData.Size()-capacity))
+	for queue.queueData.Size() > capacity {
+		evictedItem, _ := queue.queueData.RemoveMax()
+		evictedItems = append(evictedItems, evictedItem)
+		queue.evict(evictedItem)
+	}
+
+	return evictedItems, nil
+}
+
+// ----------------------------------------------------------------------------
+// Apply, Map, and Fold methods
+//
+// Methods to apply a function across ALL items in a queue.
+
+// Iterate over the queue and apply a function to each item.
+//
+// Idiomatic Go should likely use Iterator() rather than functional methods.
+//
+// BEWARE: Iteration order is not the same as priority order!
+// To iterate in priority order, use SortedItems().
+//
+// Internally this method calls minmaxheap.Apply, as the backing data structure is a heap.
+//
+// It is expected that Apply does *not* update the queue items.
+// To modify the queue items, use Map.
+// To accumulate values over the queue, use Fold.
+func Apply[T any](queue *BoundedPriorityQueue[T], f func(item T)) {
+	minmaxheap.Apply(queue.queueData, f)
+}
+
+// Iterate over the queue apply a function to each item.
+//
+// Idiomatic Go should likely use Iterator() rather than functional methods.
+//
+// BEWARE: Iteration order is not the same as priority order!
+// To iterate in priority order, use SortedItems().
+//
+// BEWARE: Since this method updates the queue data, this method must restore queue order afterwards.
+// That is potentially very expensive!
+//
+// Internally this method calls minmaxheap.Map, as the backing data structure is a heap.
+//
+// Map can update the node items by returning the update value.
+// If you do not need to modify the queue items, use Apply.
+// To accumulate values over the queue, use Fold.
+func Map[T any](queue *BoundedPriorityQueue[T], f func(item T) T) {
+	minmaxheap.Map(queue.queueData, f)
+}
+
+// Iterate over the queue and apply the function f to it.
+// The function f also takes the current value of the accumulator.
+// The results of f become the new value of the accumulator at each step.
+//
+// Idiomatic Go should likely use Iterator() rather than functional methods.
+//
+// BEWARE: Iteration order is not the same as priority order!
+// To iterate in priority order, use SortedItems().
+//
+// This function returns the final accumulator.
+//
+// Internally this method calls minmaxheap.Fold, as the backing data structure is a heap.
+//
+// This function is not a method on BoundedPriorityQueue to allow for generic accumulators.
+func Fold[T any, G any](queue *BoundedPriorityQueue[T], initialAccumulator G, f func(item T, accumulator G) G) G {
+	return minmaxheap.Fold(queue.queueData, initialAccumulator, f)
+}
+
+// Iterate over the items of the queue.
+//
+// BEWARE: Iteration order is not the same as priority order!
+// To iterate in priority order, use SortedItems().
+//
+// This method is not concurrency safe. For concurrent applications, consider using a mutex, or pull the data out using Items().
+func (queue *BoundedPriorityQueue[T]) Iterator() iter.Seq[T] {
+	return queue.queueData.Iterator()
+}
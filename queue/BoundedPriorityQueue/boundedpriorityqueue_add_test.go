@@ -0,0 +1,126 @@
+package boundedpriorityqueue_test
+
+import (
+	"errors"
+	"math/rand"
+	"slices"
+	"testing"
+
+	boundedpriorityqueue "github.com/hmcalister/Go-DSA/queue/BoundedPriorityQueue"
+	comparator "github.com/hmcalister/Go-DSA/utils/Comparator"
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
+)
+
+func TestBoundedPriorityQueueAddUntilFull(t *testing.T) {
+	queue, _ := boundedpriorityqueue.New[int](5, boundedpriorityqueue.EvictWorst, comparator.DefaultIntegerComparator)
+
+	for i, item := range []int{5, 4, 3, 2, 1} {
+		if queue.IsFull() {
+			t.Errorf("queue claims to be full with size %v", queue.Size())
+		}
+		_, evicted, err := queue.Add(item)
+		if evicted || err != nil {
+			t.Errorf("unexpected eviction (%v) or error (%v) when adding to non-full queue", evicted, err)
+		}
+		if queue.Size() != i+1 {
+			t.Errorf("queue size (%v) does not match expected size (%v)", queue.Size(), i+1)
+		}
+	}
+
+	if !queue.IsFull() {
+		t.Errorf("queue claims to not be full with size %v", queue.Size())
+	}
+}
+
+func TestBoundedPriorityQueueEvictWorst(t *testing.T) {
+	queue, _ := boundedpriorityqueue.New[int](3, boundedpriorityqueue.EvictWorst, comparator.DefaultIntegerComparator)
+	callbackItems := make([]int, 0)
+	queue.SetEvictionCallback(func(item int) { callbackItems = append(callbackItems, item) })
+
+	for _, item := range []int{5, 6, 7} {
+		queue.Add(item)
+	}
+
+	// A better item evicts the current worst
+	evictedItem, evicted, err := queue.Add(1)
+	if err != nil || !evicted || evictedItem != 7 {
+		t.Errorf("expected eviction of item 7, found (%v, %v, %v)", evictedItem, evicted, err)
+	}
+
+	// A worse item is evicted immediately
+	evictedItem, evicted, err = queue.Add(10)
+	if err != nil || !evicted || evictedItem != 10 {
+		t.Errorf("expected eviction of item 10, found (%v, %v, %v)", evictedItem, evicted, err)
+	}
+
+	expectedCallbackItems := []int{7, 10}
+	if !slices.Equal(expectedCallbackItems, callbackItems) {
+		t.Errorf("expected callback items %v does not match found callback items %v", expectedCallbackItems, callbackItems)
+	}
+
+	expectedItems := []int{1, 5, 6}
+	if !slices.Equal(expectedItems, queue.SortedItems()) {
+		t.Errorf("expected items %v does not match found items %v", expectedItems, queue.SortedItems())
+	}
+}
+
+func TestBoundedPriorityQueueRejectNew(t *testing.T) {
+	queue, _ := boundedpriorityqueue.New[int](3, boundedpriorityqueue.RejectNew, comparator.DefaultIntegerComparator)
+	callbackItems := make([]int, 0)
+	queue.SetEvictionCallback(func(item int) { callbackItems = append(callbackItems, item) })
+
+	for _, item := range []int{5, 6, 7} {
+		queue.Add(item)
+	}
+
+	_, evicted, err := queue.Add(1)
+	if !errors.Is(err, dsa_error.ErrorDataStructureFull) || evicted {
+		t.Errorf("expected error (%v) when adding to full queue, found (%v, %v)", dsa_error.ErrorDataStructureFull, evicted, err)
+	}
+	if len(callbackItems) != 0 {
+		t.Errorf("eviction callback called with items %v when rejecting new item", callbackItems)
+	}
+
+	expectedItems := []int{5, 6, 7}
+	if !slices.Equal(expectedItems, queue.SortedItems()) {
+		t.Errorf("expected items %v does not match found items %v", expectedItems, queue.SortedItems())
+	}
+}
+
+func TestBoundedPriorityQueueTopKLargest(t *testing.T) {
+	k := 10
+	queue, _ := boundedpriorityqueue.New[int](k, boundedpriorityqueue.EvictWorst, func(a, b int) int {
+		return b - a
+	})
+
+	numItems := 1000
+	items := make([]int, numItems)
+	for i := range numItems {
+		items[i] = rand.Intn(numItems)
+	}
+	for _, item := range items {
+		queue.Add(item)
+	}
+
+	slices.Sort(items)
+	slices.Reverse(items)
+	expectedItems := items[:k]
+	if !slices.Equal(expectedItems, queue.SortedItems()) {
+		t.Errorf("expected items %v does not match found items %v", expectedItems, queue.SortedItems())
+	}
+
+	for _, expectedItem := range expectedItems {
+		removedItem, err := queue.Remove()
+		if err != nil {
+			t.Errorf("encountered error (%v) when removing from non-empty queue", err)
+		}
+		if removedItem != expectedItem {
+			t.Errorf("removed item (%v) does not match expected item (%v)", removedItem, expectedItem)
+		}
+	}
+
+	_, err := queue.RemoveWorst()
+	if !errors.Is(err, dsa_error.ErrorDataStructureEmpty) {
+		t.Errorf("did not encounter expected error (%v) when removing from empty queue, found %v", dsa_error.ErrorDataStructureEmpty, err)
+	}
+}
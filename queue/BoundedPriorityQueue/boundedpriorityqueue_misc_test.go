@@ -0,0 +1,114 @@
+package boundedpriorityqueue_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	boundedpriorityqueue "github.com/hmcalister/Go-DSA/queue/BoundedPriorityQueue"
+	comparator "github.com/hmcalister/Go-DSA/utils/Comparator"
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
+)
+
+func TestBoundedPriorityQueueInit(t *testing.T) {
+	t.Run("bounded priority queue int", func(t *testing.T) {
+		boundedpriorityqueue.New[int](5, boundedpriorityqueue.EvictWorst, comparator.DefaultIntegerComparator)
+	})
+	t.Run("bounded priority queue float", func(t *testing.T) {
+		boundedpriorityqueue.New[float64](5, boundedpriorityqueue.EvictWorst, comparator.DefaultFloat64Comparator)
+	})
+	t.Run("bounded priority queue string", func(t *testing.T) {
+		boundedpriorityqueue.New[string](5, boundedpriorityqueue.RejectNew, comparator.DefaultStringComparator)
+	})
+	t.Run("bounded priority queue invalid capacity", func(t *testing.T) {
+		_, err := boundedpriorityqueue.New[int](0, boundedpriorityqueue.EvictWorst, comparator.DefaultIntegerComparator)
+		if !errors.Is(err, dsa_error.ErrorInvalidCapacity) {
+			t.Errorf("did not encounter expected error (%v) when creating queue with invalid capacity, found %v", dsa_error.ErrorInvalidCapacity, err)
+		}
+	})
+}
+
+func TestBoundedPriorityQueuePeekEmpty(t *testing.T) {
+	queue, _ := boundedpriorityqueue.New[int](5, boundedpriorityqueue.EvictWorst, comparator.DefaultIntegerComparator)
+
+	_, err := queue.Peek()
+	if !errors.Is(err, dsa_error.ErrorDataStructureEmpty) {
+		t.Errorf("did not encounter expected error (%v) when peeking at empty queue, found %v", dsa_error.ErrorDataStructureEmpty, err)
+	}
+
+	_, err = queue.PeekWorst()
+	if !errors.Is(err, dsa_error.ErrorDataStructureEmpty) {
+		t.Errorf("did not encounter expected error (%v) when peeking at empty queue, found %v", dsa_error.ErrorDataStructureEmpty, err)
+	}
+}
+
+func TestBoundedPriorityQueueResize(t *testing.T) {
+	queue, _ := boundedpriorityqueue.New[int](5, boundedpriorityqueue.EvictWorst, comparator.DefaultIntegerComparator)
+	for _, item := range []int{5, 1, 4, 2, 3} {
+		queue.Add(item)
+	}
+
+	callbackItems := make([]int, 0)
+	queue.SetEvictionCallback(func(item int) { callbackItems = append(callbackItems, item) })
+
+	evictedItems, err := queue.Resize(2)
+	if err != nil {
+		t.Errorf("encountered error (%v) when resizing queue", err)
+	}
+
+	expectedEvictedItems := []int{5, 4, 3}
+	if !slices.Equal(expectedEvictedItems, evictedItems) {
+		t.Errorf("expected evicted items %v does not match found evicted items %v", expectedEvictedItems, evictedItems)
+	}
+	if !slices.Equal(expectedEvictedItems, callbackItems) {
+		t.Errorf("expected callback items %v does not match found callback items %v", expectedEvictedItems, callbackItems)
+	}
+
+	expectedItems := []int{1, 2}
+	if !slices.Equal(expectedItems, queue.SortedItems()) {
+		t.Errorf("expected items %v does not match found items %v", expectedItems, queue.SortedItems())
+	}
+	if queue.Capacity() != 2 || !queue.IsFull() {
+		t.Errorf("expected full queue with capacity 2, found capacity %v and size %v", queue.Capacity(), queue.Size())
+	}
+
+	_, err = queue.Resize(0)
+	if !errors.Is(err, dsa_error.ErrorInvalidCapacity) {
+		t.Errorf("did not encounter expected error (%v) when resizing to invalid capacity, found %v", dsa_error.ErrorInvalidCapacity, err)
+	}
+
+	_, err = queue.Resize(10)
+	if err != nil {
+		t.Errorf("encountered error (%v) when growing queue", err)
+	}
+	if queue.IsFull() {
+		t.Errorf("queue claims to be full after growing")
+	}
+}
+
+func TestBoundedPriorityQueueIterator(t *testing.T) {
+	queue, _ := boundedpriorityqueue.New[int](5, boundedpriorityqueue.EvictWorst, comparator.DefaultIntegerComparator)
+	items := []int{1, 2, 3, 4, 5}
+	for _, item := range items {
+		queue.Add(item)
+	}
+
+	sum := 0
+	for item := range queue.Iterator() {
+		sum += item
+	}
+	foldSum := boundedpriorityqueue.Fold(queue, 0, func(item int, accumulator int) int { return accumulator + item })
+	applySum := 0
+	boundedpriorityqueue.Apply(queue, func(item int) { applySum += item })
+
+	expectedSum := 15
+	if sum != expectedSum || foldSum != expectedSum || applySum != expectedSum {
+		t.Errorf("iterator sum (%v), fold sum (%v), and apply sum (%v) do not all match expected sum (%v)", sum, foldSum, applySum, expectedSum)
+	}
+
+	boundedpriorityqueue.Map(queue, func(item int) int { return 10 - item })
+	expectedItems := []int{5, 6, 7, 8, 9}
+	if !slices.Equal(expectedItems, queue.SortedItems()) {
+		t.Errorf("expected items %v does not match found items %v after map", expectedItems, queue.SortedItems())
+	}
+}
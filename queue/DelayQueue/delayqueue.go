@@ -0,0 +1,378 @@
+package delayqueue
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	priorityqueue "github.com/hmcalister/Go-DSA/queue/PriorityQueue"
+	clock "github.com/hmcalister/Go-DSA/utils/Clock"
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
+)
+
+type entryState int
+
+const (
+	state_SCHEDULED entryState = iota
+	state_CANCELLED entryState = iota
+	state_TAKEN     entryState = iota
+)
+
+// A scheduled item, as stored in the backing priority queue.
+type delayedEntry[T any] struct {
+	item    T
+	readyAt time.Time
+
+	// Entries with the same ready time are taken in the order they were scheduled
+	sequence uint64
+
+	state entryState
+}
+
+// Compare two entries by ready time, breaking ties by the order they were scheduled.
+func compareEntries[T any](a, b *delayedEntry[T]) int {
+	if compare := a.readyAt.Compare(b.readyAt); compare != 0 {
+		return compare
+	}
+	if a.sequence < b.sequence {
+		return -1
+	}
+	if a.sequence > b.sequence {
+		return +1
+	}
+	return 0
+}
+
+// A handle to an item scheduled in a DelayQueue, allowing the item to be cancelled or rescheduled.
+type Handle[T any] struct {
+	queue *DelayQueue[T]
+
+	// The scheduled item. This never changes, so is stored here to be read without locking the queue.
+	item T
+
+	// The current entry of the item. Replaced by Reschedule, so only accessed while holding the queue mutex.
+	entry *delayedEntry[T]
+}
+
+// Get the item this handle refers to. This method is safe to call while the item is rescheduled concurrently.
+func (handle *Handle[T]) Item() T {
+	return handle.item
+}
+
+// Implement a delay queue, in which each item only becomes available once its scheduled time has passed.
+//
+// Items are taken in order of their scheduled time, earliest first. Items with the same scheduled time are taken
+// in the order they were scheduled.
+//
+// This implementation uses github.com/hmcalister/Go-DSA/queue/PriorityQueue ordered by scheduled time.
+// Cancelled and rescheduled items are removed lazily, so the backing queue may briefly hold more entries than Size() reports.
+//
+// A DelayQueue is safe for concurrent use.
+type DelayQueue[T any] struct {
+	mutex     sync.Mutex
+	queueData *priorityqueue.PriorityQueue[*delayedEntry[T]]
+	clock     clock.Clock
+
+	// The number of scheduled (not cancelled) entries in queueData
+	size int
+
+	// The number of cancelled entries still in queueData
+	cancelledCount int
+
+	nextSequence uint64
+	closed       bool
+
+	// Closed (and replaced) whenever the state of the queue changes, waking all waiting goroutines.
+	stateChanged chan struct{}
+}
+
+// Create a new DelayQueue using the system clock.
+func New[T any]() *DelayQueue[T] {
+	return NewWithClock[T](clock.SystemClock{})
+}
+
+// Create a new DelayQueue using the given clock.
+//
+// See github.com/hmcalister/Go-DSA/utils/Clock, in particular ManualClock for deterministic tests.
+func NewWithClock[T any](clock clock.Clock) *DelayQueue[T] {
+	return &DelayQueue[T]{
+		queueData:      priorityqueue.New(compareEntries[T]),
+		clock:          clock,
+		size:           0,
+		cancelledCount: 0,
+		nextSequence:   0,
+		closed:         false,
+		stateChanged:   make(chan struct{}),
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helper Methods
+//
+// All helper methods must be called while holding the mutex.
+
+// Wake all goroutines waiting on a change of state.
+func (queue *DelayQueue[T]) broadcast() {
+	close(queue.stateChanged)
+	queue.stateChanged = make(chan struct{})
+}
+
+// Add a new entry to the backing queue.
+func (queue *DelayQueue[T]) addEntry(item T, readyAt time.Time) *delayedEntry[T] {
+	entry := &delayedEntry[T]{
+		item:     item,
+		readyAt:  readyAt,
+		sequence: queue.nextSequence,
+		state:    state_SCHEDULED,
+	}
+	queue.nextSequence += 1
+	queue.queueData.Add(entry)
+	queue.size += 1
+	return entry
+}
+
+// Mark an entry as cancelled, to be removed from the backing queue lazily.
+func (queue *DelayQueue[T]) cancelEntry(cancelledEntry *delayedEntry[T]) {
+	cancelledEntry.state = state_CANCELLED
+	queue.size -= 1
+	queue.cancelledCount += 1
+
+	// If the majority of the backing queue is cancelled entries, rebuild the queue
+	// so that memory is not held indefinitely by items scheduled far in the future.
+	// The scheduled entries are collected first so the new queue is built in linear time.
+	if queue.cancelledCount > queue.size {
+		scheduledEntries := make([]*delayedEntry[T], 0, queue.size)
+		for entry := range queue.queueData.Iterator() {
+			if entry.state == state_SCHEDULED {
+				scheduledEntries = append(scheduledEntries, entry)
+			}
+		}
+		queue.queueData = priorityqueue.NewFromSlice(scheduledEntries, compareEntries[T])
+		queue.cancelledCount = 0
+	}
+}
+
+// Get the earliest scheduled entry, discarding any cancelled entries at the front of the queue.
+//
+// Returns nil if there are no scheduled entries.
+func (queue *DelayQueue[T]) frontEntry() *delayedEntry[T] {
+	for {
+		entry, err := queue.queueData.Peek()
+		if err != nil {
+			return nil
+		}
+		if entry.state == state_SCHEDULED {
+			return entry
+		}
+		queue.queueData.Remove()
+		queue.cancelledCount -= 1
+	}
+}
+
+// Remove the front entry, which must be scheduled, and return its item.
+func (queue *DelayQueue[T]) takeFrontEntry() T {
+	entry, _ := queue.queueData.Remove()
+	entry.state = state_TAKEN
+	queue.size -= 1
+	queue.broadcast()
+	return entry.item
+}
+
+// Check that a handle refers to a scheduled entry of this queue.
+func (queue *DelayQueue[T]) validateHandle(handle *Handle[T]) error {
+	if handle == nil || handle.queue != queue || handle.entry.state != state_SCHEDULED {
+		return ErrorInvalidHandle
+	}
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Get Methods
+
+// Peek at the earliest scheduled item, along with the time it becomes ready. The item may not yet be ready.
+//
+// Returns a dsa_error.ErrorDataStructureEmpty if the queue is empty.
+func (queue *DelayQueue[T]) Peek() (T, time.Time, error) {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	entry := queue.frontEntry()
+	if entry == nil {
+		return *new(T), time.Time{}, dsa_error.ErrorDataStructureEmpty
+	}
+	return entry.item, entry.readyAt, nil
+}
+
+// Get the size of the queue, the number of scheduled items (ready or not).
+func (queue *DelayQueue[T]) Size() int {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	return queue.size
+}
+
+// Determine if the queue has been closed.
+func (queue *DelayQueue[T]) IsClosed() bool {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	return queue.closed
+}
+
+// ----------------------------------------------------------------------------
+// Add Methods
+
+// Schedule an item to become ready at the given time. A time in the past means the item is ready immediately.
+//
+// Returns a handle that can be used to cancel or reschedule the item,
+// or dsa_error.ErrorQueueClosed if the queue has been closed.
+func (queue *DelayQueue[T]) Schedule(item T, readyAt time.Time) (*Handle[T], error) {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	if queue.closed {
+		return nil, dsa_error.ErrorQueueClosed
+	}
+
+	entry := queue.addEntry(item, readyAt)
+	queue.broadcast()
+	return &Handle[T]{
+		queue: queue,
+		item:  item,
+		entry: entry,
+	}, nil
+}
+
+// Schedule an item to become ready after the given delay, as measured by the queue's clock.
+//
+// See Schedule.
+func (queue *DelayQueue[T]) ScheduleAfter(item T, delay time.Duration) (*Handle[T], error) {
+	return queue.Schedule(item, queue.clock.Now().Add(delay))
+}
+
+// Change the time a scheduled item becomes ready.
+//
+// Returns ErrorInvalidHandle if the item has already been taken or cancelled, or the handle belongs to another queue.
+func (queue *DelayQueue[T]) Reschedule(handle *Handle[T], readyAt time.Time) error {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	if err := queue.validateHandle(handle); err != nil {
+		return err
+	}
+
+	// The backing priority queue cannot reorder an item in place,
+	// so cancel the old entry and point the handle to a new one.
+	queue.cancelEntry(handle.entry)
+	handle.entry = queue.addEntry(handle.item, readyAt)
+	queue.broadcast()
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Remove Methods
+
+// Cancel a scheduled item, removing it from the queue. The cancelled item is returned.
+//
+// Returns ErrorInvalidHandle if the item has already been taken or cancelled, or the handle belongs to another queue.
+func (queue *DelayQueue[T]) Cancel(handle *Handle[T]) (T, error) {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	if err := queue.validateHandle(handle); err != nil {
+		return *new(T), err
+	}
+
+	queue.cancelEntry(handle.entry)
+	queue.broadcast()
+	return handle.item, nil
+}
+
+// Take the earliest item, blocking until its scheduled time has passed.
+//
+// If an earlier item is scheduled while waiting, that item is taken instead once it is ready.
+//
+// Returns dsa_error.ErrorQueueClosed if the queue is (or becomes) closed while no item is ready,
+// or the context error if the context is cancelled before an item is ready.
+func (queue *DelayQueue[T]) Take(ctx context.Context) (T, error) {
+	queue.mutex.Lock()
+	for {
+		entry := queue.frontEntry()
+		if entry != nil && !entry.readyAt.After(queue.clock.Now()) {
+			item := queue.takeFrontEntry()
+			queue.mutex.Unlock()
+			return item, nil
+		}
+
+		if queue.closed {
+			queue.mutex.Unlock()
+			return *new(T), dsa_error.ErrorQueueClosed
+		}
+
+		// Wait until the front item may be ready, or the queue changes (which may change the front item).
+		// If the queue is empty, readyChannel is nil and hence never selected.
+		var readyTimer *clock.Timer
+		var readyChannel <-chan time.Time
+		if entry != nil {
+			readyTimer = queue.clock.NewTimer(entry.readyAt.Sub(queue.clock.Now()))
+			readyChannel = readyTimer.C
+		}
+		stateChanged := queue.stateChanged
+		queue.mutex.Unlock()
+		cancelled := false
+		select {
+		case <-ctx.Done():
+			cancelled = true
+		case <-stateChanged:
+		case <-readyChannel:
+		}
+
+		// Stop the timer so that waking early does not leave a waiter on the clock
+		if readyTimer != nil {
+			readyTimer.Stop()
+		}
+		if cancelled {
+			return *new(T), ctx.Err()
+		}
+		queue.mutex.Lock()
+	}
+}
+
+// Take the earliest item without blocking.
+//
+// Returns a dsa_error.ErrorDataStructureEmpty if the queue is empty,
+// or ErrorNoItemReady if no item's scheduled time has passed yet.
+func (queue *DelayQueue[T]) TryTake() (T, error) {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	entry := queue.frontEntry()
+	if entry == nil {
+		return *new(T), dsa_error.ErrorDataStructureEmpty
+	}
+	if entry.readyAt.After(queue.clock.Now()) {
+		return *new(T), ErrorNoItemReady
+	}
+	return queue.takeFrontEntry(), nil
+}
+
+// ----------------------------------------------------------------------------
+// Close Methods
+
+// Close the queue, waking all waiting goroutines.
+//
+// After closing, Schedule returns dsa_error.ErrorQueueClosed.
+// Items that are already ready may still be taken, but Take no longer waits for items to become ready,
+// returning dsa_error.ErrorQueueClosed instead.
+//
+// Closing an already closed queue has no effect.
+func (queue *DelayQueue[T]) Close() {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	if queue.closed {
+		return
+	}
+	queue.closed = true
+	queue.broadcast()
+}
@@ -0,0 +1,292 @@
+package delayqueue_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	delayqueue "github.com/hmcalister/Go-DSA/queue/DelayQueue"
+	clock "github.com/hmcalister/Go-DSA/utils/Clock"
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
+)
+
+var startTime = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+func TestDelayQueueInit(t *testing.T) {
+	t.Run("delay queue int", func(t *testing.T) {
+		delayqueue.New[int]()
+	})
+	t.Run("delay queue string", func(t *testing.T) {
+		delayqueue.NewWithClock[string](clock.NewManualClock(startTime))
+	})
+}
+
+func TestDelayQueueTryTakeOrder(t *testing.T) {
+	manualClock := clock.NewManualClock(startTime)
+	queue := delayqueue.NewWithClock[string](manualClock)
+
+	if _, err := queue.TryTake(); !errors.Is(err, dsa_error.ErrorDataStructureEmpty) {
+		t.Errorf("did not encounter expected error (%v) when taking from empty queue, found %v", dsa_error.ErrorDataStructureEmpty, err)
+	}
+
+	queue.ScheduleAfter("c", 3*time.Second)
+	queue.ScheduleAfter("a", 1*time.Second)
+	queue.ScheduleAfter("b", 2*time.Second)
+	queue.ScheduleAfter("b2", 2*time.Second)
+
+	if _, err := queue.TryTake(); !errors.Is(err, delayqueue.ErrorNoItemReady) {
+		t.Errorf("did not encounter expected error (%v) when taking before items are ready, found %v", delayqueue.ErrorNoItemReady, err)
+	}
+
+	item, readyAt, err := queue.Peek()
+	if err != nil || item != "a" || !readyAt.Equal(startTime.Add(time.Second)) {
+		t.Errorf("expected to peek item a ready at %v, found (%v, %v, %v)", startTime.Add(time.Second), item, readyAt, err)
+	}
+
+	manualClock.Advance(2 * time.Second)
+	for _, expectedItem := range []string{"a", "b", "b2"} {
+		item, err := queue.TryTake()
+		if err != nil || item != expectedItem {
+			t.Errorf("expected to take item %v, found (%v, %v)", expectedItem, item, err)
+		}
+	}
+
+	if _, err := queue.TryTake(); !errors.Is(err, delayqueue.ErrorNoItemReady) {
+		t.Errorf("did not encounter expected error (%v) when taking before items are ready, found %v", delayqueue.ErrorNoItemReady, err)
+	}
+	if queue.Size() != 1 {
+		t.Errorf("queue size (%v) does not match expected size (%v)", queue.Size(), 1)
+	}
+}
+
+func TestDelayQueueTakeBlocksUntilReady(t *testing.T) {
+	manualClock := clock.NewManualClock(startTime)
+	queue := delayqueue.NewWithClock[int](manualClock)
+	queue.ScheduleAfter(1, 5*time.Second)
+
+	result := make(chan int)
+	go func() {
+		item, err := queue.Take(context.Background())
+		if err != nil {
+			t.Errorf("encountered error (%v) when taking from queue", err)
+		}
+		result <- item
+	}()
+
+	manualClock.BlockUntilWaiters(1)
+	manualClock.Advance(4 * time.Second)
+	select {
+	case item := <-result:
+		t.Fatalf("take returned item %v before it was ready", item)
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	manualClock.Advance(time.Second)
+	if item := <-result; item != 1 {
+		t.Errorf("taken item (%v) does not match expected item (%v)", item, 1)
+	}
+}
+
+func TestDelayQueueTakeEarlierScheduleWhileWaiting(t *testing.T) {
+	manualClock := clock.NewManualClock(startTime)
+	queue := delayqueue.NewWithClock[int](manualClock)
+	queue.ScheduleAfter(1, 10*time.Second)
+
+	result := make(chan int)
+	go func() {
+		item, _ := queue.Take(context.Background())
+		result <- item
+	}()
+
+	manualClock.BlockUntilWaiters(1)
+	queue.ScheduleAfter(2, time.Second)
+	manualClock.Advance(time.Second)
+
+	if item := <-result; item != 2 {
+		t.Errorf("taken item (%v) does not match expected item (%v)", item, 2)
+	}
+}
+
+func TestDelayQueueCancel(t *testing.T) {
+	manualClock := clock.NewManualClock(startTime)
+	queue := delayqueue.NewWithClock[int](manualClock)
+
+	handles := make([]*delayqueue.Handle[int], 0)
+	for i := range 10 {
+		handle, _ := queue.ScheduleAfter(i, time.Duration(i)*time.Second)
+		handles = append(handles, handle)
+	}
+
+	// Cancel all even items
+	for i := 0; i < len(handles); i += 2 {
+		item, err := queue.Cancel(handles[i])
+		if err != nil || item != i {
+			t.Errorf("expected to cancel item %v, found (%v, %v)", i, item, err)
+		}
+	}
+	if _, err := queue.Cancel(handles[0]); !errors.Is(err, delayqueue.ErrorInvalidHandle) {
+		t.Errorf("did not encounter expected error (%v) when cancelling twice, found %v", delayqueue.ErrorInvalidHandle, err)
+	}
+	if queue.Size() != 5 {
+		t.Errorf("queue size (%v) does not match expected size (%v)", queue.Size(), 5)
+	}
+
+	manualClock.Advance(time.Minute)
+	for i := 1; i < len(handles); i += 2 {
+		item, err := queue.TryTake()
+		if err != nil || item != i {
+			t.Errorf("expected to take item %v, found (%v, %v)", i, item, err)
+		}
+	}
+
+	if _, err := queue.Cancel(handles[1]); !errors.Is(err, delayqueue.ErrorInvalidHandle) {
+		t.Errorf("did not encounter expected error (%v) when cancelling taken item, found %v", delayqueue.ErrorInvalidHandle, err)
+	}
+	if _, err := queue.TryTake(); !errors.Is(err, dsa_error.ErrorDataStructureEmpty) {
+		t.Errorf("did not encounter expected error (%v) when taking from empty queue, found %v", dsa_error.ErrorDataStructureEmpty, err)
+	}
+}
+
+func TestDelayQueueCancelOtherQueueHandle(t *testing.T) {
+	queue := delayqueue.NewWithClock[int](clock.NewManualClock(startTime))
+	otherQueue := delayqueue.NewWithClock[int](clock.NewManualClock(startTime))
+
+	handle, _ := otherQueue.ScheduleAfter(1, time.Second)
+	if _, err := queue.Cancel(handle); !errors.Is(err, delayqueue.ErrorInvalidHandle) {
+		t.Errorf("did not encounter expected error (%v) when cancelling handle from another queue, found %v", delayqueue.ErrorInvalidHandle, err)
+	}
+	if otherQueue.Size() != 1 {
+		t.Errorf("queue size (%v) does not match expected size (%v)", otherQueue.Size(), 1)
+	}
+}
+
+func TestDelayQueueReschedule(t *testing.T) {
+	manualClock := clock.NewManualClock(startTime)
+	queue := delayqueue.NewWithClock[string](manualClock)
+
+	handleA, _ := queue.ScheduleAfter("a", time.Second)
+	queue.ScheduleAfter("b", 2*time.Second)
+
+	if err := queue.Reschedule(handleA, startTime.Add(3*time.Second)); err != nil {
+		t.Errorf("encountered error (%v) when rescheduling item", err)
+	}
+	if queue.Size() != 2 {
+		t.Errorf("queue size (%v) does not match expected size (%v)", queue.Size(), 2)
+	}
+
+	manualClock.Advance(time.Second)
+	if _, err := queue.TryTake(); !errors.Is(err, delayqueue.ErrorNoItemReady) {
+		t.Errorf("did not encounter expected error (%v) after rescheduling, found %v", delayqueue.ErrorNoItemReady, err)
+	}
+
+	manualClock.Advance(2 * time.Second)
+	for _, expectedItem := range []string{"b", "a"} {
+		item, err := queue.TryTake()
+		if err != nil || item != expectedItem {
+			t.Errorf("expected to take item %v, found (%v, %v)", expectedItem, item, err)
+		}
+	}
+
+	if err := queue.Reschedule(handleA, startTime); !errors.Is(err, delayqueue.ErrorInvalidHandle) {
+		t.Errorf("did not encounter expected error (%v) when rescheduling taken item, found %v", delayqueue.ErrorInvalidHandle, err)
+	}
+}
+
+// Run with -race to check the handle may be read while its item is rescheduled.
+func TestDelayQueueConcurrentRescheduleItem(t *testing.T) {
+	manualClock := clock.NewManualClock(startTime)
+	queue := delayqueue.NewWithClock[string](manualClock)
+	handle, _ := queue.ScheduleAfter("a", time.Second)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for step := range 1000 {
+			queue.Reschedule(handle, startTime.Add(time.Duration(step)*time.Second))
+		}
+	}()
+	for range 1000 {
+		if item := handle.Item(); item != "a" {
+			t.Errorf("found handle item (%v) does not match expected item (%v)", item, "a")
+		}
+	}
+	<-done
+
+	if item, err := queue.Cancel(handle); err != nil || item != "a" {
+		t.Errorf("expected to cancel item %v, found (%v, %v)", "a", item, err)
+	}
+}
+
+func TestDelayQueueContextCancel(t *testing.T) {
+	queue := delayqueue.NewWithClock[int](clock.NewManualClock(startTime))
+	queue.ScheduleAfter(1, time.Hour)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := queue.Take(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("did not encounter expected error (%v) when taking before item is ready, found %v", context.DeadlineExceeded, err)
+	}
+}
+
+func TestDelayQueueTakeStopsTimers(t *testing.T) {
+	manualClock := clock.NewManualClock(startTime)
+	queue := delayqueue.NewWithClock[int](manualClock)
+	queue.ScheduleAfter(1, time.Hour)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	result := make(chan error)
+	go func() {
+		_, err := queue.Take(ctx)
+		result <- err
+	}()
+
+	// Each schedule wakes the waiting goroutine, which must stop its timer before waiting again
+	manualClock.BlockUntilWaiters(1)
+	for i := range 10 {
+		queue.ScheduleAfter(i, time.Hour)
+	}
+	cancel()
+	if err := <-result; !errors.Is(err, context.Canceled) {
+		t.Errorf("did not encounter expected error (%v) after cancelling take, found %v", context.Canceled, err)
+	}
+	if manualClock.Waiters() != 0 {
+		t.Errorf("clock waiters (%v) does not match expected waiters (%v)", manualClock.Waiters(), 0)
+	}
+}
+
+func TestDelayQueueClose(t *testing.T) {
+	manualClock := clock.NewManualClock(startTime)
+	queue := delayqueue.NewWithClock[int](manualClock)
+	queue.ScheduleAfter(1, 0)
+	queue.ScheduleAfter(2, time.Hour)
+
+	result := make(chan error)
+	go func() {
+		queue.Take(context.Background())
+		_, err := queue.Take(context.Background())
+		result <- err
+	}()
+
+	manualClock.BlockUntilWaiters(1)
+	queue.Close()
+	if err := <-result; !errors.Is(err, dsa_error.ErrorQueueClosed) {
+		t.Errorf("did not encounter expected error (%v) after close, found %v", dsa_error.ErrorQueueClosed, err)
+	}
+
+	if _, err := queue.Schedule(3, startTime); !errors.Is(err, dsa_error.ErrorQueueClosed) {
+		t.Errorf("did not encounter expected error (%v) when scheduling on closed queue, found %v", dsa_error.ErrorQueueClosed, err)
+	}
+}
+
+func TestDelayQueueSystemClock(t *testing.T) {
+	queue := delayqueue.New[int]()
+	queue.ScheduleAfter(1, 5*time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	item, err := queue.Take(ctx)
+	if err != nil || item != 1 {
+		t.Errorf("expected to take item 1, found (%v, %v)", item, err)
+	}
+}
@@ -0,0 +1,8 @@
+package delayqueue
+
+import "errors"
+
+var (
+	ErrorNoItemReady   = errors.New("no item in queue is ready")
+	ErrorInvalidHandle = errors.New("handle does not refer to a scheduled item in this queue")
+)
@@ -0,0 +1,288 @@
+package deque
+
+import (
+	"iter"
+
+	circularbuffer "github.com/hmcalister/Go-DSA/queue/internal/CircularBuffer"
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
+)
+
+// Implement a double ended queue (deque) using an array as a circular buffer.
+//
+// A deque allows items to be added and removed from both the front and back in amortized O(1) time,
+// and allows any item to be accessed by index in O(1) time.
+// The backing array doubles in size when full, and halves in size when at most a quarter full.
+type Deque[T any] struct {
+	dequeData circularbuffer.CircularBuffer[T]
+}
+
+// Create a new Deque using an array as a circular buffer.
+func New[T any]() *Deque[T] {
+	return &Deque[T]{
+		dequeData: circularbuffer.New[T](),
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Get Methods
+
+// Peek at the front item in the deque.
+//
+// Returns a dsa_error.ErrorDataStructureEmpty if the deque is empty.
+func (deque *Deque[T]) PeekFront() (T, error) {
+	if deque.dequeData.Size() == 0 {
+		return *new(T), dsa_error.ErrorDataStructureEmpty
+	}
+
+	return deque.dequeData.At(0), nil
+}
+
+// Peek at the back item in the deque.
+//
+// Returns a dsa_error.ErrorDataStructureEmpty if the deque is empty.
+func (deque *Deque[T]) PeekBack() (T, error) {
+	if deque.dequeData.Size() == 0 {
+		return *new(T), dsa_error.ErrorDataStructureEmpty
+	}
+
+	return deque.dequeData.At(deque.dequeData.Size() - 1), nil
+}
+
+// Get the item at the given index, counted from the front of the deque.
+//
+// Returns a dsa_error.ErrorIndexOutOfBounds if the index is out of bounds.
+func (deque *Deque[T]) At(index int) (T, error) {
+	if index < 0 || deque.dequeData.Size() <= index {
+		return *new(T), dsa_error.ErrorIndexOutOfBounds
+	}
+
+	return deque.dequeData.At(index), nil
+}
+
+// Find the first item in a deque matching a predicate.
+// The deque is traversed from front to back.
+//
+// Returns (item, nil) if the item is present, or (*new(T), dsa_error.ErrorItemNotFound) if the item is not present.
+func (deque *Deque[T]) Find(predicate func(item T) bool) (T, error) {
+	for index := 0; index < deque.dequeData.Size(); index += 1 {
+		item := deque.dequeData.At(index)
+		if predicate(item) {
+			return item, nil
+		}
+	}
+	return *new(T), dsa_error.ErrorItemNotFound
+}
+
+// Find all items in a deque matching a predicate.
+// The deque is traversed from front to back.
+//
+// Returns all items from the deque that match the predicate.
+func (deque *Deque[T]) FindAll(predicate func(item T) bool) []T {
+	foundItems := make([]T, 0)
+	for index := 0; index < deque.dequeData.Size(); index += 1 {
+		item := deque.dequeData.At(index)
+		if predicate(item) {
+			foundItems = append(foundItems, item)
+		}
+	}
+	return foundItems
+}
+
+// Get all items from the deque, front first. This method allocates an array of length equal to the number of items.
+func (deque *Deque[T]) Items() []T {
+	items := make([]T, deque.dequeData.Size())
+	for index := 0; index < deque.dequeData.Size(); index += 1 {
+		items[index] = deque.dequeData.At(index)
+	}
+	return items
+}
+
+// Get the size of the deque, the number of items in the deque.
+func (deque *Deque[T]) Size() int {
+	return deque.dequeData.Size()
+}
+
+// ----------------------------------------------------------------------------
+// Set Methods
+
+// Set the item at the given index, counted from the front of the deque.
+//
+// Returns a dsa_error.ErrorIndexOutOfBounds if the index is out of bounds.
+func (deque *Deque[T]) Set(index int, item T) error {
+	if index < 0 || deque.dequeData.Size() <= index {
+		return dsa_error.ErrorIndexOutOfBounds
+	}
+
+	deque.dequeData.Set(index, item)
+	return nil
+}
+
+// Rotate the deque n steps to the right (towards the back).
+// Rotating one step to the right moves the back item to the front.
+// If n is negative, rotate to the left instead, moving the front item to the back.
+//
+// Rotating takes O(min(k, size-k)) time, where k is the number of steps modulo the size of the deque.
+func (deque *Deque[T]) Rotate(n int) {
+	deque.dequeData.Rotate(n)
+}
+
+// ----------------------------------------------------------------------------
+// Add Methods
+
+// Add an item to the front of the deque.
+func (deque *Deque[T]) PushFront(item T) {
+	deque.dequeData.PushFront(item)
+}
+
+// Add an item to the back of the deque.
+func (deque *Deque[T]) PushBack(item T) {
+	deque.dequeData.PushBack(item)
+}
+
+// ----------------------------------------------------------------------------
+// Remove Methods
+
+// Remove and return the front item of the deque.
+//
+// Returns a dsa_error.ErrorDataStructureEmpty if the deque is empty.
+func (deque *Deque[T]) PopFront() (T, error) {
+	if deque.dequeData.Size() == 0 {
+		return *new(T), dsa_error.ErrorDataStructureEmpty
+	}
+
+	return deque.dequeData.PopFront(), nil
+}
+
+// Remove and return the back item of the deque.
+//
+// Returns a dsa_error.ErrorDataStructureEmpty if the deque is empty.
+func (deque *Deque[T]) PopBack() (T, error) {
+	if deque.dequeData.Size() == 0 {
+		return *new(T), dsa_error.ErrorDataStructureEmpty
+	}
+
+	return deque.dequeData.PopBack(), nil
+}
+
+// ----------------------------------------------------------------------------
+// Apply, Map, and Fold methods
+//
+// Methods to apply a function across ALL items in a deque.
+
+// Iterate over the deque in the forward direction (front to back) and apply a function to each item.
+//
+// Idiomatic Go should likely use ForwardIterator() rather than functional methods.
+//
+// It is expected that ForwardApply does *not* update the deque items.
+// To modify the deque items, use ForwardMap.
+// To accumulate values over the deque, use ForwardFold.
+func ForwardApply[T any](deque *Deque[T], f func(item T)) {
+	for index := 0; index < deque.dequeData.Size(); index += 1 {
+		f(deque.dequeData.At(index))
+	}
+}
+
+// Iterate over the deque in the forward direction (front to back) and apply a function to each item
+// The result of this function is then assigned to the item at each step.
+//
+// Idiomatic Go should likely use ForwardIterator() rather than functional methods.
+//
+// ForwardMap can update the items by returning the update value.
+// If you do not need to modify the deque items, use ForwardApply.
+// To accumulate values over the deque, use ForwardFold.
+func ForwardMap[T any](deque *Deque[T], f func(item T) T) {
+	for index := 0; index < deque.dequeData.Size(); index += 1 {
+		deque.dequeData.Set(index, f(deque.dequeData.At(index)))
+	}
+}
+
+// Iterate over the deque (front to back) and apply the function f to it.
+// The function f also takes the current value of the accumulator.
+// The results of f become the new value of the accumulator at each step.
+//
+// This function returns the final accumulator.
+//
+// Idiomatic Go should likely use ForwardIterator() rather than functional methods.
+//
+// This function is not a method on Deque to allow for generic accumulators.
+func ForwardFold[T any, G any](deque *Deque[T], initialAccumulator G, f func(item T, accumulator G) G) G {
+	accumulator := initialAccumulator
+	for index := 0; index < deque.dequeData.Size(); index += 1 {
+		accumulator = f(deque.dequeData.At(index), accumulator)
+	}
+
+	return accumulator
+}
+
+// Iterate over the deque in the reverse direction (back to front) and apply a function to each item.
+//
+// Idiomatic Go should likely use ReverseIterator() rather than functional methods.
+//
+// It is expected that ReverseApply does *not* update the deque items.
+// To modify the deque items, use ReverseMap.
+// To accumulate values over the deque, use ReverseFold.
+func ReverseApply[T any](deque *Deque[T], f func(item T)) {
+	for index := deque.dequeData.Size() - 1; index >= 0; index -= 1 {
+		f(deque.dequeData.At(index))
+	}
+}
+
+// Iterate over the deque in the reverse direction (back to front) and apply a function to each item
+// The result of this function is then assigned to the item at each step.
+//
+// Idiomatic Go should likely use ReverseIterator() rather than functional methods.
+//
+// ReverseMap can update the items by returning the update value.
+// If you do not need to modify the deque items, use ReverseApply.
+// To accumulate values over the deque, use ReverseFold.
+func ReverseMap[T any](deque *Deque[T], f func(item T) T) {
+	for index := deque.dequeData.Size() - 1; index >= 0; index -= 1 {
+		deque.dequeData.Set(index, f(deque.dequeData.At(index)))
+	}
+}
+
+// Iterate over the deque (back to front) and apply the function f to it.
+// The function f also takes the current value of the accumulator.
+// The results of f become the new value of the accumulator at each step.
+//
+// This function returns the final accumulator.
+//
+// Idiomatic Go should likely use ReverseIterator() rather than functional methods.
+//
+// This function is not a method on Deque to allow for generic accumulators.
+func ReverseFold[T any, G any](deque *Deque[T], initialAccumulator G, f func(item T, accumulator G) G) G {
+	accumulator := initialAccumulator
+	for index := deque.dequeData.Size() - 1; index >= 0; index -= 1 {
+		accumulator = f(deque.dequeData.At(index), accumulator)
+	}
+
+	return accumulator
+}
+
+// Iterate over the items of the deque in the forward direction (front to back).
+// Returns both the index (as counted from the front of the deque) and item.
+// This method is not concurrency safe. For concurrent applications, consider using a mutex, or pull the data out using Items().
+func (deque *Deque[T]) ForwardIterator() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for index := 0; index < deque.dequeData.Size(); index += 1 {
+			item := deque.dequeData.At(index)
+			if !yield(index, item) {
+				break
+			}
+		}
+	}
+}
+
+// Iterate over the items of the deque in the reverse direction (back to front).
+// Returns both the index (as counted from the back of the deque) and item.
+// This method is not concurrency safe. For concurrent applications, consider using a mutex, or pull the data out using Items().
+func (deque *Deque[T]) ReverseIterator() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for index := 0; index < deque.dequeData.Size(); index += 1 {
+			item := deque.dequeData.At(deque.dequeData.Size() - index - 1)
+			if !yield(index, item) {
+				break
+			}
+		}
+	}
+}
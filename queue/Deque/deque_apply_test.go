@@ -0,0 +1,100 @@
+package deque_test
+
+import (
+	"slices"
+	"testing"
+
+	deque "github.com/hmcalister/Go-DSA/queue/Deque"
+)
+
+func newTestDeque(items []string) *deque.Deque[string] {
+	d := deque.New[string]()
+	for _, item := range items {
+		d.PushBack(item)
+	}
+	return d
+}
+
+func TestDequeForwardApply(t *testing.T) {
+	items := []string{"a", "b", "c", "d", "e", "f", "g", "h", "i"}
+	d := newTestDeque(items)
+
+	concatString := ""
+	deque.ForwardApply(d, func(item string) { concatString += item })
+	if concatString != "abcdefghi" {
+		t.Errorf("result (%v) does not match expected result (%v)", concatString, "abcdefghi")
+	}
+}
+
+func TestDequeReverseApply(t *testing.T) {
+	items := []string{"a", "b", "c", "d", "e", "f", "g", "h", "i"}
+	d := newTestDeque(items)
+
+	concatString := ""
+	deque.ReverseApply(d, func(item string) { concatString += item })
+	if concatString != "ihgfedcba" {
+		t.Errorf("result (%v) does not match expected result (%v)", concatString, "ihgfedcba")
+	}
+}
+
+func TestDequeMap(t *testing.T) {
+	items := []string{"a", "b", "c"}
+	d := newTestDeque(items)
+
+	deque.ForwardMap(d, func(item string) string { return item + item })
+	expectedItems := []string{"aa", "bb", "cc"}
+	if !slices.Equal(expectedItems, d.Items()) {
+		t.Errorf("found items %v do not match expected items %v", d.Items(), expectedItems)
+	}
+
+	deque.ReverseMap(d, func(item string) string { return item + "!" })
+	expectedItems = []string{"aa!", "bb!", "cc!"}
+	if !slices.Equal(expectedItems, d.Items()) {
+		t.Errorf("found items %v do not match expected items %v", d.Items(), expectedItems)
+	}
+}
+
+func TestDequeFold(t *testing.T) {
+	items := []string{"a", "b", "c", "d"}
+	d := newTestDeque(items)
+
+	forwardString := deque.ForwardFold(d, "", func(item string, accumulator string) string { return accumulator + item })
+	if forwardString != "abcd" {
+		t.Errorf("result (%v) does not match expected result (%v)", forwardString, "abcd")
+	}
+
+	reverseString := deque.ReverseFold(d, "", func(item string, accumulator string) string { return accumulator + item })
+	if reverseString != "dcba" {
+		t.Errorf("result (%v) does not match expected result (%v)", reverseString, "dcba")
+	}
+}
+
+func TestDequeIterators(t *testing.T) {
+	items := []string{"a", "b", "c", "d"}
+	d := newTestDeque(items)
+	d.PushFront("z")
+
+	forwardString := ""
+	for index, item := range d.ForwardIterator() {
+		expectedItem, _ := d.At(index)
+		if item != expectedItem {
+			t.Errorf("forward iterator item (%v) at index %v does not match expected item (%v)", item, index, expectedItem)
+		}
+		forwardString += item
+	}
+	if forwardString != "zabcd" {
+		t.Errorf("result (%v) does not match expected result (%v)", forwardString, "zabcd")
+	}
+
+	reverseString := ""
+	for index, item := range d.ReverseIterator() {
+		expectedItem, _ := d.At(d.Size() - index - 1)
+		if item != expectedItem {
+			t.Errorf("reverse iterator item (%v) at index %v does not match expected item (%v)", item, index, expectedItem)
+		}
+		reverseString += item
+	}
+	if reverseString != "dcbaz" {
+		t.Errorf("result (%v) does not match expected result (%v)", reverseString, "dcbaz")
+	}
+}
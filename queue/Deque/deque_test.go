@@ -0,0 +1,207 @@
+package deque_test
+
+import (
+	"errors"
+	"math/rand"
+	"slices"
+	"testing"
+
+	deque "github.com/hmcalister/Go-DSA/queue/Deque"
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
+)
+
+// ----------------------------------------------------------------------------
+// Initialization Tests
+
+func TestDequeInit(t *testing.T) {
+	t.Run("deque int", func(t *testing.T) {
+		deque.New[int]()
+	})
+	t.Run("deque float", func(t *testing.T) {
+		deque.New[float64]()
+	})
+	t.Run("deque string", func(t *testing.T) {
+		deque.New[string]()
+	})
+	t.Run("deque struct", func(t *testing.T) {
+		type S struct {
+			_ int
+			_ float64
+			_ string
+		}
+		deque.New[S]()
+	})
+}
+
+// ----------------------------------------------------------------------------
+// Empty Tests
+
+func TestDequeEmpty(t *testing.T) {
+	d := deque.New[int]()
+
+	if _, err := d.PeekFront(); !errors.Is(err, dsa_error.ErrorDataStructureEmpty) {
+		t.Errorf("did not encounter expected error (%v) when peeking front of empty deque, found %v", dsa_error.ErrorDataStructureEmpty, err)
+	}
+	if _, err := d.PeekBack(); !errors.Is(err, dsa_error.ErrorDataStructureEmpty) {
+		t.Errorf("did not encounter expected error (%v) when peeking back of empty deque, found %v", dsa_error.ErrorDataStructureEmpty, err)
+	}
+	if _, err := d.PopFront(); !errors.Is(err, dsa_error.ErrorDataStructureEmpty) {
+		t.Errorf("did not encounter expected error (%v) when popping front of empty deque, found %v", dsa_error.ErrorDataStructureEmpty, err)
+	}
+	if _, err := d.PopBack(); !errors.Is(err, dsa_error.ErrorDataStructureEmpty) {
+		t.Errorf("did not encounter expected error (%v) when popping back of empty deque, found %v", dsa_error.ErrorDataStructureEmpty, err)
+	}
+	if _, err := d.Find(func(item int) bool { return true }); !errors.Is(err, dsa_error.ErrorItemNotFound) {
+		t.Errorf("did not encounter expected error (%v) when finding in empty deque, found %v", dsa_error.ErrorItemNotFound, err)
+	}
+	if len(d.FindAll(func(item int) bool { return true })) != 0 {
+		t.Errorf("found a non-zero number of items from an empty deque")
+	}
+	d.Rotate(3)
+}
+
+// ----------------------------------------------------------------------------
+// Push and Pop Tests
+
+func TestDequePushPop(t *testing.T) {
+	d := deque.New[int]()
+
+	// d = [3, 2, 1, 4, 5, 6]
+	d.PushFront(1)
+	d.PushFront(2)
+	d.PushFront(3)
+	d.PushBack(4)
+	d.PushBack(5)
+	d.PushBack(6)
+
+	expectedItems := []int{3, 2, 1, 4, 5, 6}
+	if !slices.Equal(expectedItems, d.Items()) {
+		t.Errorf("found items %v do not match expected items %v", d.Items(), expectedItems)
+	}
+
+	if item, err := d.PeekFront(); err != nil || item != 3 {
+		t.Errorf("expected front item 3, found (%v, %v)", item, err)
+	}
+	if item, err := d.PeekBack(); err != nil || item != 6 {
+		t.Errorf("expected back item 6, found (%v, %v)", item, err)
+	}
+
+	if item, err := d.PopFront(); err != nil || item != 3 {
+		t.Errorf("expected to pop front item 3, found (%v, %v)", item, err)
+	}
+	if item, err := d.PopBack(); err != nil || item != 6 {
+		t.Errorf("expected to pop back item 6, found (%v, %v)", item, err)
+	}
+	if d.Size() != 4 {
+		t.Errorf("found deque size (%v) does not match the expected size (%v)", d.Size(), 4)
+	}
+}
+
+func TestDequeRandomOperations(t *testing.T) {
+	d := deque.New[int]()
+	expectedItems := make([]int, 0)
+
+	for item := range 5000 {
+		switch rand.Intn(5) {
+		case 0, 1:
+			d.PushBack(item)
+			expectedItems = append(expectedItems, item)
+		case 2:
+			d.PushFront(item)
+			expectedItems = append([]int{item}, expectedItems...)
+		case 3:
+			removedItem, err := d.PopFront()
+			if len(expectedItems) == 0 {
+				if err == nil {
+					t.Fatalf("did not encounter error when popping front of empty deque")
+				}
+				continue
+			}
+			if removedItem != expectedItems[0] {
+				t.Fatalf("popped front item (%v) does not match the expected item (%v)", removedItem, expectedItems[0])
+			}
+			expectedItems = expectedItems[1:]
+		case 4:
+			removedItem, err := d.PopBack()
+			if len(expectedItems) == 0 {
+				if err == nil {
+					t.Fatalf("did not encounter error when popping back of empty deque")
+				}
+				continue
+			}
+			if removedItem != expectedItems[len(expectedItems)-1] {
+				t.Fatalf("popped back item (%v) does not match the expected item (%v)", removedItem, expectedItems[len(expectedItems)-1])
+			}
+			expectedItems = expectedItems[:len(expectedItems)-1]
+		}
+	}
+
+	if !slices.Equal(expectedItems, d.Items()) {
+		t.Errorf("found items %v do not match expected items %v", d.Items(), expectedItems)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Index Tests
+
+func TestDequeAtAndSet(t *testing.T) {
+	d := deque.New[int]()
+	for item := range 10 {
+		d.PushFront(item)
+	}
+
+	// d = [9, 8, ..., 0]
+	for index := range 10 {
+		item, err := d.At(index)
+		if err != nil || item != 9-index {
+			t.Errorf("expected item %v at index %v, found (%v, %v)", 9-index, index, item, err)
+		}
+		if err := d.Set(index, index); err != nil {
+			t.Errorf("encountered error (%v) when setting index %v", err, index)
+		}
+	}
+
+	for index := range 10 {
+		item, _ := d.At(index)
+		if item != index {
+			t.Errorf("expected item %v at index %v after set, found %v", index, index, item)
+		}
+	}
+
+	for _, index := range []int{-1, 10, 100} {
+		if _, err := d.At(index); !errors.Is(err, dsa_error.ErrorIndexOutOfBounds) {
+			t.Errorf("did not encounter expected error (%v) when getting index %v, found %v", dsa_error.ErrorIndexOutOfBounds, index, err)
+		}
+		if err := d.Set(index, 0); !errors.Is(err, dsa_error.ErrorIndexOutOfBounds) {
+			t.Errorf("did not encounter expected error (%v) when setting index %v, found %v", dsa_error.ErrorIndexOutOfBounds, index, err)
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Rotate Tests
+
+func TestDequeRotate(t *testing.T) {
+	// Test both a full backing array (8 items) and a partially full backing array (5 items)
+	for _, numItems := range []int{5, 8} {
+		for _, steps := range []int{-13, -4, -1, 0, 1, 2, 3, 4, 7, 13} {
+			d := deque.New[int]()
+			items := make([]int, numItems)
+			for index := range numItems {
+				items[index] = index
+				d.PushBack(index)
+			}
+			// Move the head of the circular buffer away from index zero
+			d.PushFront(-1)
+			d.PopFront()
+
+			d.Rotate(steps)
+
+			normalizedSteps := ((steps % numItems) + numItems) % numItems
+			expectedItems := append(slices.Clone(items[numItems-normalizedSteps:]), items[:numItems-normalizedSteps]...)
+			if !slices.Equal(expectedItems, d.Items()) {
+				t.Errorf("rotate %v steps of %v items: found items %v do not match expected items %v", steps, numItems, d.Items(), expectedItems)
+			}
+		}
+	}
+}
@@ -84,6 +84,30 @@ func (queue *LinkedListQueue[T]) Remove() (T, error) {
 	return queue.queueData.RemoveAtIndex(0)
 }
 
+// Remove and return the first item in the queue matching a predicate.
+// The queue is traversed from front to back.
+//
+// Returns (item, nil) if an item was removed, or (*new(T), dsa_error.ErrorItemNotFound) if no item matches.
+func (queue *LinkedListQueue[T]) RemoveFirst(predicate func(item T) bool) (T, error) {
+	return queue.queueData.RemoveFirst(predicate)
+}
+
+// Remove all items in the queue matching a predicate. The remaining items keep their order.
+// The queue is traversed once from front to back.
+//
+// Returns the number of items removed.
+func (queue *LinkedListQueue[T]) RemoveAll(predicate func(item T) bool) int {
+	return queue.queueData.RemoveAll(predicate)
+}
+
+// Keep only the items in the queue matching a predicate, removing all others. The remaining items keep their order.
+// The queue is traversed once from front to back.
+//
+// Returns the number of items removed.
+func (queue *LinkedListQueue[T]) Retain(predicate func(item T) bool) int {
+	return queue.queueData.Retain(predicate)
+}
+
 // ----------------------------------------------------------------------------
 // Apply, Map, and Fold methods
 //
@@ -1,9 +1,11 @@
 package linkedlistqueue_test
 
 import (
+	"errors"
 	"testing"
 
 	linkedlistqueue "github.com/hmcalister/Go-DSA/queue/LinkedListQueue"
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
 )
 
 func TestLinkedListQueueRemove(t *testing.T) {
@@ -137,3 +139,29 @@ func TestLinkedListQueueCheckFindAllAfterRemove(t *testing.T) {
 		t.Errorf("found a non-zero number of items from a queue with expected zero number of matches")
 	}
 }
+
+func TestLinkedListQueueRemovePredicate(t *testing.T) {
+	queue := linkedlistqueue.New[int]()
+	for _, item := range []int{0, 1, 2, 3, 4, 5} {
+		queue.Add(item)
+	}
+
+	// Unlink the front node, then a middle and the back node
+	if item, err := queue.RemoveFirst(func(item int) bool { return item%2 == 0 }); err != nil || item != 0 {
+		t.Errorf("found removed item (%v, %v) does not match expected item (%v, %v)", item, err, 0, nil)
+	}
+	if numRemoved := queue.RemoveAll(func(item int) bool { return item == 2 || item == 5 }); numRemoved != 2 {
+		t.Errorf("found number removed (%v) does not match expected number removed (%v)", numRemoved, 2)
+	}
+	if _, err := queue.RemoveFirst(func(item int) bool { return item > 100 }); !errors.Is(err, dsa_error.ErrorItemNotFound) {
+		t.Errorf("expected ErrorItemNotFound when no item matches, found (%v)", err)
+	}
+
+	// New items must be linked behind the remaining nodes
+	queue.Add(6)
+	for _, expectedItem := range []int{1, 3, 4, 6} {
+		if item, err := queue.Remove(); err != nil || item != expectedItem {
+			t.Errorf("found removed item (%v, %v) does not match expected item (%v, %v)", item, err, expectedItem, nil)
+		}
+	}
+}
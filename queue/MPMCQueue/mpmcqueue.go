@@ -0,0 +1,156 @@
+package mpmcqueue
+
+import (
+	"math/bits"
+	"sync/atomic"
+
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
+)
+
+// The assumed size of a CPU cache line, used to pad frequently written fields
+// so that producers and consumers do not contend on the same cache line (false sharing).
+const cacheLineSize = 64
+
+// A slot in the queue's circular buffer.
+type cell[T any] struct {
+	// The sequence number of this cell determines who may access it next.
+	//
+	// If sequence == position, the cell is empty and may be written by the producer claiming that position.
+	// If sequence == position+1, the cell is full and may be read by the consumer claiming that position.
+	sequence atomic.Uint64
+	item     T
+}
+
+// Implement a bounded, lock-free, multi-producer multi-consumer (MPMC) queue.
+//
+// This is the bounded queue described by Dmitry Vyukov: a circular buffer where each slot carries a sequence number.
+// Producers and consumers claim positions with a single compare-and-swap, so no goroutine ever holds a lock,
+// and a goroutine being descheduled can never block the progress of others beyond its own claimed slot.
+//
+// Items are removed in the order they were added (first in, first out).
+//
+// An MPMCQueue is safe for concurrent use by any number of goroutines.
+// Add and Remove never block: they return an error when the queue is full or empty respectively.
+type MPMCQueue[T any] struct {
+	_ [cacheLineSize]byte
+
+	// The next position to be claimed by a producer
+	enqueuePosition atomic.Uint64
+	_               [cacheLineSize - 8]byte
+
+	// The next position to be claimed by a consumer
+	dequeuePosition atomic.Uint64
+	_               [cacheLineSize - 8]byte
+
+	// The circular buffer, with length a power of two
+	buffer []cell[T]
+
+	// len(buffer)-1, so that position&mask is the index of a position in the buffer
+	mask uint64
+}
+
+// Create a new MPMCQueue with at least the given capacity.
+//
+// The capacity is rounded up to the next power of two (and is at least 2), which can be checked with Capacity().
+func New[T any](capacity int) *MPMCQueue[T] {
+	roundedCapacity := uint64(1) << bits.Len(uint(max(2, capacity)-1))
+
+	queue := &MPMCQueue[T]{
+		buffer: make([]cell[T], roundedCapacity),
+		mask:   roundedCapacity - 1,
+	}
+	for index := range queue.buffer {
+		queue.buffer[index].sequence.Store(uint64(index))
+	}
+	return queue
+}
+
+// ----------------------------------------------------------------------------
+// Get Methods
+
+// Get the capacity of the queue, the maximum number of items the queue may hold.
+func (queue *MPMCQueue[T]) Capacity() int {
+	return len(queue.buffer)
+}
+
+// Get the approximate size of the queue, the number of items in the queue.
+//
+// Since other goroutines may modify the queue concurrently, the size is only a snapshot
+// and may be out of date as soon as it is returned.
+func (queue *MPMCQueue[T]) Size() int {
+	// Load the dequeue position first, so the difference is never negative
+	dequeuePosition := queue.dequeuePosition.Load()
+	enqueuePosition := queue.enqueuePosition.Load()
+	return int(min(enqueuePosition-dequeuePosition, uint64(len(queue.buffer))))
+}
+
+// ----------------------------------------------------------------------------
+// Add Methods
+
+// Add an item to the back of the queue. This method never blocks.
+//
+// Returns a dsa_error.ErrorDataStructureFull if the queue is full.
+func (queue *MPMCQueue[T]) Add(item T) error {
+	position := queue.enqueuePosition.Load()
+	var targetCell *cell[T]
+	for {
+		targetCell = &queue.buffer[position&queue.mask]
+		sequence := targetCell.sequence.Load()
+		difference := int64(sequence - position)
+
+		if difference == 0 {
+			// The cell is empty, try to claim this position
+			if queue.enqueuePosition.CompareAndSwap(position, position+1) {
+				break
+			}
+			position = queue.enqueuePosition.Load()
+		} else if difference < 0 {
+			// The cell still holds an item from one lap ago, so the queue is full
+			return dsa_error.ErrorDataStructureFull
+		} else {
+			// Another producer claimed this position, try again from the latest position
+			position = queue.enqueuePosition.Load()
+		}
+	}
+
+	// We own the cell, write the item then publish it to consumers
+	targetCell.item = item
+	targetCell.sequence.Store(position + 1)
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Remove Methods
+
+// Remove and return the front item of the queue. This method never blocks.
+//
+// Returns a dsa_error.ErrorDataStructureEmpty if the queue is empty.
+func (queue *MPMCQueue[T]) Remove() (T, error) {
+	position := queue.dequeuePosition.Load()
+	var targetCell *cell[T]
+	for {
+		targetCell = &queue.buffer[position&queue.mask]
+		sequence := targetCell.sequence.Load()
+		difference := int64(sequence - (position + 1))
+
+		if difference == 0 {
+			// The cell is full, try to claim this position
+			if queue.dequeuePosition.CompareAndSwap(position, position+1) {
+				break
+			}
+			position = queue.dequeuePosition.Load()
+		} else if difference < 0 {
+			// The cell has not yet been written, so the queue is empty
+			return *new(T), dsa_error.ErrorDataStructureEmpty
+		} else {
+			// Another consumer claimed this position, try again from the latest position
+			position = queue.dequeuePosition.Load()
+		}
+	}
+
+	// We own the cell, read the item then release the cell to the producer of the next lap
+	item := targetCell.item
+	targetCell.item = *new(T)
+	targetCell.sequence.Store(position + queue.mask + 1)
+	return item, nil
+}
@@ -0,0 +1,285 @@
+package mpmcqueue_test
+
+import (
+	"errors"
+	"runtime"
+	"sync"
+	"testing"
+
+	arrayqueue "github.com/hmcalister/Go-DSA/queue/ArrayQueue"
+	mpmcqueue "github.com/hmcalister/Go-DSA/queue/MPMCQueue"
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
+)
+
+func TestMPMCQueueInit(t *testing.T) {
+	t.Run("int", func(t *testing.T) {
+		mpmcqueue.New[int](8)
+	})
+	t.Run("float", func(t *testing.T) {
+		mpmcqueue.New[float64](8)
+	})
+	t.Run("string", func(t *testing.T) {
+		mpmcqueue.New[string](8)
+	})
+	t.Run("struct", func(t *testing.T) {
+		type S struct {
+			_ int
+			_ float64
+			_ string
+		}
+		mpmcqueue.New[S](8)
+	})
+}
+
+func TestMPMCQueueCapacity(t *testing.T) {
+	testCases := []struct {
+		requested int
+		expected  int
+	}{
+		{-1, 2},
+		{0, 2},
+		{1, 2},
+		{2, 2},
+		{3, 4},
+		{8, 8},
+		{9, 16},
+		{1000, 1024},
+	}
+	for _, testCase := range testCases {
+		queue := mpmcqueue.New[int](testCase.requested)
+		if queue.Capacity() != testCase.expected {
+			t.Errorf("found capacity (%v) does not match expected capacity (%v) for requested capacity (%v)", queue.Capacity(), testCase.expected, testCase.requested)
+		}
+	}
+}
+
+func TestMPMCQueueAddRemove(t *testing.T) {
+	queue := mpmcqueue.New[int](4)
+
+	if _, err := queue.Remove(); !errors.Is(err, dsa_error.ErrorDataStructureEmpty) {
+		t.Errorf("removing from empty queue gave unexpected error (%v)", err)
+	}
+
+	// Run several laps of the buffer to exercise the sequence numbers
+	for lap := range 5 {
+		for i := range 4 {
+			if err := queue.Add(lap*10 + i); err != nil {
+				t.Fatalf("lap %v: failed to add item (%v): %v", lap, i, err)
+			}
+		}
+		if queue.Size() != 4 {
+			t.Errorf("found size (%v) does not match expected size (%v)", queue.Size(), 4)
+		}
+		if err := queue.Add(-1); !errors.Is(err, dsa_error.ErrorDataStructureFull) {
+			t.Errorf("adding to full queue gave unexpected error (%v)", err)
+		}
+
+		for i := range 4 {
+			item, err := queue.Remove()
+			if err != nil {
+				t.Fatalf("lap %v: failed to remove item: %v", lap, err)
+			}
+			if item != lap*10+i {
+				t.Errorf("found item (%v) does not match expected item (%v)", item, lap*10+i)
+			}
+		}
+		if _, err := queue.Remove(); !errors.Is(err, dsa_error.ErrorDataStructureEmpty) {
+			t.Errorf("removing from empty queue gave unexpected error (%v)", err)
+		}
+		if queue.Size() != 0 {
+			t.Errorf("found size (%v) does not match expected size (%v)", queue.Size(), 0)
+		}
+	}
+}
+
+func TestMPMCQueueInterleaved(t *testing.T) {
+	queue := mpmcqueue.New[int](4)
+	next := 0
+	expected := 0
+	for range 100 {
+		queue.Add(next)
+		next += 1
+		queue.Add(next)
+		next += 1
+
+		item, _ := queue.Remove()
+		if item != expected {
+			t.Fatalf("found item (%v) does not match expected item (%v)", item, expected)
+		}
+		expected += 1
+		item, _ = queue.Remove()
+		if item != expected {
+			t.Fatalf("found item (%v) does not match expected item (%v)", item, expected)
+		}
+		expected += 1
+	}
+}
+
+// Run many producers and consumers against a small queue, so that the queue is frequently full and empty.
+// Every item must be received exactly once, and items from each producer must be received by each consumer in order.
+//
+// Run with -race to check the memory ordering of the queue.
+func TestMPMCQueueConcurrentStress(t *testing.T) {
+	const numProducers = 8
+	const numConsumers = 8
+	itemsPerProducer := 20000
+	if testing.Short() {
+		itemsPerProducer = 2000
+	}
+
+	type item struct {
+		producer int
+		sequence int
+	}
+
+	queue := mpmcqueue.New[item](16)
+	received := make([][]item, numConsumers)
+
+	var producersWaitGroup sync.WaitGroup
+	for producer := range numProducers {
+		producersWaitGroup.Add(1)
+		go func() {
+			defer producersWaitGroup.Done()
+			for sequence := range itemsPerProducer {
+				for queue.Add(item{producer, sequence}) != nil {
+					runtime.Gosched()
+				}
+			}
+		}()
+	}
+
+	var remaining sync.WaitGroup
+	remaining.Add(numProducers * itemsPerProducer)
+	done := make(chan struct{})
+	go func() {
+		remaining.Wait()
+		close(done)
+	}()
+
+	var consumersWaitGroup sync.WaitGroup
+	for consumer := range numConsumers {
+		consumersWaitGroup.Add(1)
+		go func() {
+			defer consumersWaitGroup.Done()
+			for {
+				receivedItem, err := queue.Remove()
+				if err == nil {
+					received[consumer] = append(received[consumer], receivedItem)
+					remaining.Done()
+					continue
+				}
+				select {
+				case <-done:
+					return
+				default:
+					runtime.Gosched()
+				}
+			}
+		}()
+	}
+
+	producersWaitGroup.Wait()
+	consumersWaitGroup.Wait()
+
+	seen := make([][]bool, numProducers)
+	for producer := range seen {
+		seen[producer] = make([]bool, itemsPerProducer)
+	}
+	for consumer, items := range received {
+		lastSequence := make([]int, numProducers)
+		for producer := range lastSequence {
+			lastSequence[producer] = -1
+		}
+		for _, receivedItem := range items {
+			if seen[receivedItem.producer][receivedItem.sequence] {
+				t.Fatalf("item (%v) received more than once", receivedItem)
+			}
+			seen[receivedItem.producer][receivedItem.sequence] = true
+			if receivedItem.sequence <= lastSequence[receivedItem.producer] {
+				t.Fatalf("consumer %v received item (%v) after sequence (%v)", consumer, receivedItem, lastSequence[receivedItem.producer])
+			}
+			lastSequence[receivedItem.producer] = receivedItem.sequence
+		}
+	}
+	for producer := range seen {
+		for sequence, wasSeen := range seen[producer] {
+			if !wasSeen {
+				t.Fatalf("item (%v, %v) was never received", producer, sequence)
+			}
+		}
+	}
+	if queue.Size() != 0 {
+		t.Errorf("found size (%v) does not match expected size (%v)", queue.Size(), 0)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Benchmarks
+//
+// Compare the lock-free queue against an ArrayQueue guarded by a mutex.
+
+type mutexArrayQueue[T any] struct {
+	mutex     sync.Mutex
+	queueData *arrayqueue.ArrayQueue[T]
+}
+
+func (queue *mutexArrayQueue[T]) Add(item T) error {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	queue.queueData.Add(item)
+	return nil
+}
+
+func (queue *mutexArrayQueue[T]) Remove() (T, error) {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	return queue.queueData.Remove()
+}
+
+type benchmarkQueue interface {
+	Add(item int) error
+	Remove() (int, error)
+}
+
+// Each parallel goroutine alternates adding and removing an item, so the queue never fills.
+func benchmarkAddRemoveParallel(b *testing.B, queue benchmarkQueue) {
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			for queue.Add(1) != nil {
+				runtime.Gosched()
+			}
+			for {
+				if _, err := queue.Remove(); err == nil {
+					break
+				}
+				runtime.Gosched()
+			}
+		}
+	})
+}
+
+func BenchmarkMPMCQueueAddRemoveParallel(b *testing.B) {
+	benchmarkAddRemoveParallel(b, mpmcqueue.New[int](1024))
+}
+
+func BenchmarkMutexArrayQueueAddRemoveParallel(b *testing.B) {
+	benchmarkAddRemoveParallel(b, &mutexArrayQueue[int]{queueData: arrayqueue.New[int]()})
+}
+
+func BenchmarkMPMCQueueAddRemoveSequential(b *testing.B) {
+	queue := mpmcqueue.New[int](1024)
+	for range b.N {
+		queue.Add(1)
+		queue.Remove()
+	}
+}
+
+func BenchmarkMutexArrayQueueAddRemoveSequential(b *testing.B) {
+	queue := &mutexArrayQueue[int]{queueData: arrayqueue.New[int]()}
+	for range b.N {
+		queue.Add(1)
+		queue.Remove()
+	}
+}
@@ -30,6 +30,17 @@ func New[T any](comparatorFunction comparator.ComparatorFunction[T]) *PriorityQu
 	}
 }
 
+// Create a new priority queue containing the given items.
+//
+// The items are copied into the queue in linear time, which is faster than adding them one at a time.
+// See New for details on the comparatorFunction.
+func NewFromSlice[T any](items []T, comparatorFunction comparator.ComparatorFunction[T]) *PriorityQueue[T] {
+	return &PriorityQueue[T]{
+		queueData:          minbinaryheap.NewFromSlice(items, comparatorFunction),
+		comparatorFunction: comparatorFunction,
+	}
+}
+
 // ----------------------------------------------------------------------------
 // Get Methods
 
@@ -119,7 +130,7 @@ func (queue *PriorityQueue[T]) Remove() (T, error) {
 // Idiomatic Go should likely use Iterator() rather than functional methods.
 //
 // BEWARE: Iteration order is not the same as priority order!
-// To iterate in priority order, use Items() and sort by priority.
+// To iterate in priority order without modifying the queue, use IteratorSorted().
 //
 // Since Apply does not update the queue items, this method does *not* call heapify (reorganize the queue).
 //
@@ -137,7 +148,7 @@ func Apply[T any](queue *PriorityQueue[T], f func(item T)) {
 // Idiomatic Go should likely use Iterator() rather than functional methods.
 //
 // BEWARE: Iteration order is not the same as priority order!
-// To iterate in priority order, use Items() and sort by priority.
+// To iterate in priority order without modifying the queue, use IteratorSorted().
 //
 // BEWARE: Since this method updates the queue data, this method calls heapify to restore queue order.
 // However, since this method may update *all* queue items, this method calls heapify on *all* items.
@@ -159,7 +170,7 @@ func Map[T any](queue *PriorityQueue[T], f func(item T) T) {
 // Idiomatic Go should likely use Iterator() rather than functional methods.
 //
 // BEWARE: Iteration order is not the same as priority order!
-// To iterate in priority order, use Items() and sort by priority.
+// To iterate in priority order without modifying the queue, use IteratorSorted().
 //
 // This function returns the final accumulator.
 //
@@ -173,7 +184,7 @@ func Fold[T any, G any](queue *PriorityQueue[T], initialAccumulator G, f func(it
 // Iterate over the items of the queue.
 //
 // BEWARE: Iteration order is not the same as priority order!
-// To iterate in priority order, use Items() and sort by priority.
+// To iterate in priority order without modifying the queue, use IteratorSorted().
 //
 // If you are updating items in the queue, please note this method does *not* reheapify.
 //
@@ -181,3 +192,26 @@ func Fold[T any, G any](queue *PriorityQueue[T], initialAccumulator G, f func(it
 func (queue *PriorityQueue[T]) Iterator() iter.Seq[T] {
 	return queue.queueData.Iterator()
 }
+
+// Iterate over the items of the queue in priority order, front of the queue first.
+//
+// Unlike continually calling Remove(), this method does *not* modify the queue.
+// Items are produced lazily, and visiting the first k items takes O(k log n) time,
+// so breaking out of the iteration early is cheap.
+//
+// The queue must not be modified during iteration.
+//
+// Internally this method calls minbinaryheap.IteratorSorted, as the backing data structure is a heap.
+//
+// This method is not concurrency safe. For concurrent applications, consider using a mutex, or pull the data out using Items().
+func (queue *PriorityQueue[T]) IteratorSorted() iter.Seq[T] {
+	return queue.queueData.IteratorSorted()
+}
+
+// Get the first k items of the queue, in priority order. The queue is not modified.
+//
+// If k is larger than the size of the queue, all items of the queue are returned.
+// If k is not positive, an empty slice is returned.
+func (queue *PriorityQueue[T]) TopK(k int) []T {
+	return queue.queueData.TopK(k)
+}
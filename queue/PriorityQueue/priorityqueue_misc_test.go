@@ -38,6 +38,18 @@ func TestPriorityQueueInit(t *testing.T) {
 	})
 }
 
+func TestPriorityQueueNewFromSlice(t *testing.T) {
+	queue := priorityqueue.NewFromSlice([]int{5, 2, 8, 1, 9, 3}, comparator.DefaultIntegerComparator)
+
+	expectedItems := []int{1, 2, 3, 5, 8, 9}
+	for _, expectedItem := range expectedItems {
+		item, err := queue.Remove()
+		if err != nil || item != expectedItem {
+			t.Errorf("found removed item (%v, %v) does not match expected item (%v, %v)", item, err, expectedItem, nil)
+		}
+	}
+}
+
 func TestCheckPeekOfEmptyPriorityQueue(t *testing.T) {
 	queue := priorityqueue.New[int](comparator.DefaultIntegerComparator)
 
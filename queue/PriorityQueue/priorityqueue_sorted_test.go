@@ -0,0 +1,53 @@
+package priorityqueue_test
+
+import (
+	"slices"
+	"testing"
+
+	priorityqueue "github.com/hmcalister/Go-DSA/queue/PriorityQueue"
+	comparator "github.com/hmcalister/Go-DSA/utils/Comparator"
+)
+
+func TestPriorityQueueIteratorSorted(t *testing.T) {
+	queue := priorityqueue.New[int](comparator.DefaultIntegerComparator)
+	items := []int{5, 3, 9, 1, 7, 2, 8, 4, 6}
+	for _, item := range items {
+		queue.Add(item)
+	}
+
+	foundOrder := make([]int, 0)
+	for item := range queue.IteratorSorted() {
+		foundOrder = append(foundOrder, item)
+	}
+
+	expectedOrder := []int{1, 2, 3, 4, 5, 6, 7, 8, 9}
+	if !slices.Equal(expectedOrder, foundOrder) {
+		t.Errorf("expected order %v does not match found order %v", expectedOrder, foundOrder)
+	}
+
+	if queue.Size() != len(items) {
+		t.Errorf("queue size (%v) does not match expected size (%v) after sorted iteration", queue.Size(), len(items))
+	}
+}
+
+func TestPriorityQueueTopK(t *testing.T) {
+	queue := priorityqueue.New[int](comparator.DefaultIntegerComparator)
+	items := []int{5, 3, 9, 1, 7, 2, 8, 4, 6}
+	for _, item := range items {
+		queue.Add(item)
+	}
+
+	foundItems := queue.TopK(3)
+	expectedItems := []int{1, 2, 3}
+	if !slices.Equal(expectedItems, foundItems) {
+		t.Errorf("expected items %v does not match found items %v", expectedItems, foundItems)
+	}
+
+	peekItem, err := queue.Peek()
+	if err != nil {
+		t.Errorf("encountered error (%v) after peeking at non-empty queue", err)
+	}
+	if peekItem != 1 {
+		t.Errorf("found peek item (%v) does not match the expected item (%v)", peekItem, 1)
+	}
+}
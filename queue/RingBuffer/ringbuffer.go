@@ -0,0 +1,328 @@
+package ringbuffer
+
+import (
+	"iter"
+
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
+)
+
+// Determines the behavior of a RingBuffer when an item is pushed to a full buffer.
+type FullPolicy int
+
+const (
+	// When the buffer is full, overwrite the oldest item with the new item.
+	OverwriteOldest FullPolicy = iota
+
+	// When the buffer is full, reject the new item with a dsa_error.ErrorDataStructureFull.
+	RejectNew FullPolicy = iota
+)
+
+// Implement a fixed capacity circular buffer (ring buffer).
+//
+// Items are removed in the order they were added (first in, first out), like a queue.
+// Unlike a queue, the buffer never grows: once full, new items either overwrite the oldest item
+// or are rejected, as chosen at construction. This is useful for keeping the last N log lines or samples.
+type RingBuffer[T any] struct {
+	// The backing array, allocated once with length equal to the capacity.
+	//
+	// Slots not holding an item are always the zero value of T, so removed items are not kept reachable.
+	bufferData []T
+
+	// The index of the oldest item in bufferData.
+	head int
+
+	// The number of items in the buffer.
+	size int
+
+	policy FullPolicy
+}
+
+// Create a new RingBuffer holding at most capacity items.
+//
+// The policy determines what happens when an item is pushed to a full buffer, see FullPolicy.
+//
+// Returns a dsa_error.ErrorInvalidCapacity if the capacity is not positive.
+func New[T any](capacity int, policy FullPolicy) (*RingBuffer[T], error) {
+	if capacity <= 0 {
+		return nil, dsa_error.ErrorInvalidCapacity
+	}
+
+	return &RingBuffer[T]{
+		bufferData: make([]T, capacity),
+		head:       0,
+		size:       0,
+		policy:     policy,
+	}, nil
+}
+
+// Convert an index counted from the oldest item into an index of the backing array.
+func (buffer *RingBuffer[T]) dataIndex(index int) int {
+	return (buffer.head + index) % len(buffer.bufferData)
+}
+
+// ----------------------------------------------------------------------------
+// Get Methods
+
+// Peek at the oldest item in the buffer, the next item to be popped.
+//
+// Returns a dsa_error.ErrorDataStructureEmpty if the buffer is empty.
+func (buffer *RingBuffer[T]) Peek() (T, error) {
+	if buffer.size == 0 {
+		return *new(T), dsa_error.ErrorDataStructureEmpty
+	}
+
+	return buffer.bufferData[buffer.head], nil
+}
+
+// Peek at the newest item in the buffer, the item most recently pushed.
+//
+// Returns a dsa_error.ErrorDataStructureEmpty if the buffer is empty.
+func (buffer *RingBuffer[T]) PeekNewest() (T, error) {
+	if buffer.size == 0 {
+		return *new(T), dsa_error.ErrorDataStructureEmpty
+	}
+
+	return buffer.bufferData[buffer.dataIndex(buffer.size-1)], nil
+}
+
+// Get the item at the given index, counted from the oldest item (index 0) to the newest item (index Size()-1).
+//
+// Returns a dsa_error.ErrorIndexOutOfBounds if the index is out of bounds.
+func (buffer *RingBuffer[T]) At(index int) (T, error) {
+	if index < 0 || buffer.size <= index {
+		return *new(T), dsa_error.ErrorIndexOutOfBounds
+	}
+
+	return buffer.bufferData[buffer.dataIndex(index)], nil
+}
+
+// Find the first item in the buffer matching a predicate.
+// The buffer is traversed from oldest to newest.
+//
+// Returns (item, nil) if the item is present, or (*new(T), dsa_error.ErrorItemNotFound) if the item is not present.
+func (buffer *RingBuffer[T]) Find(predicate func(item T) bool) (T, error) {
+	for index := 0; index < buffer.size; index += 1 {
+		item := buffer.bufferData[buffer.dataIndex(index)]
+		if predicate(item) {
+			return item, nil
+		}
+	}
+	return *new(T), dsa_error.ErrorItemNotFound
+}
+
+// Find all items in the buffer matching a predicate.
+// The buffer is traversed from oldest to newest.
+//
+// Returns all items from the buffer that match the predicate.
+func (buffer *RingBuffer[T]) FindAll(predicate func(item T) bool) []T {
+	foundItems := make([]T, 0)
+	for index := 0; index < buffer.size; index += 1 {
+		item := buffer.bufferData[buffer.dataIndex(index)]
+		if predicate(item) {
+			foundItems = append(foundItems, item)
+		}
+	}
+	return foundItems
+}
+
+// Get a snapshot of all items from the buffer, oldest first. This method allocates an array of length equal to the number of items.
+func (buffer *RingBuffer[T]) Items() []T {
+	items := make([]T, buffer.size)
+	for index := 0; index < buffer.size; index += 1 {
+		items[index] = buffer.bufferData[buffer.dataIndex(index)]
+	}
+	return items
+}
+
+// Get the size of the buffer, the number of items in the buffer.
+func (buffer *RingBuffer[T]) Size() int {
+	return buffer.size
+}
+
+// Get the capacity of the buffer, the maximum number of items the buffer may hold.
+func (buffer *RingBuffer[T]) Capacity() int {
+	return len(buffer.bufferData)
+}
+
+// Determine if the buffer is full, i.e. if the size of the buffer is equal to the capacity.
+func (buffer *RingBuffer[T]) IsFull() bool {
+	return buffer.size == len(buffer.bufferData)
+}
+
+// ----------------------------------------------------------------------------
+// Add Methods
+
+// Push an item to the buffer as the newest item.
+//
+// If the buffer is not full, the item is always added and (*new(T), false, nil) is returned.
+//
+// If the buffer is full and the policy is OverwriteOldest, the oldest item is overwritten and returned as (overwrittenItem, true, nil).
+//
+// If the buffer is full and the policy is RejectNew, the item is not added and (*new(T), false, dsa_error.ErrorDataStructureFull) is returned.
+func (buffer *RingBuffer[T]) Push(item T) (T, bool, error) {
+	if !buffer.IsFull() {
+		buffer.bufferData[buffer.dataIndex(buffer.size)] = item
+		buffer.size += 1
+		return *new(T), false, nil
+	}
+
+	if buffer.policy == RejectNew {
+		return *new(T), false, dsa_error.ErrorDataStructureFull
+	}
+
+	// The buffer is full, so the oldest item is in the slot the new item will take
+	overwrittenItem := buffer.bufferData[buffer.head]
+	buffer.bufferData[buffer.head] = item
+	buffer.head = (buffer.head + 1) % len(buffer.bufferData)
+	return overwrittenItem, true, nil
+}
+
+// ----------------------------------------------------------------------------
+// Remove Methods
+
+// Pop the oldest item from the buffer.
+//
+// Returns a dsa_error.ErrorDataStructureEmpty if the buffer is empty.
+func (buffer *RingBuffer[T]) Pop() (T, error) {
+	if buffer.size == 0 {
+		return *new(T), dsa_error.ErrorDataStructureEmpty
+	}
+
+	item := buffer.bufferData[buffer.head]
+	buffer.bufferData[buffer.head] = *new(T)
+	buffer.head = (buffer.head + 1) % len(buffer.bufferData)
+	buffer.size -= 1
+	return item, nil
+}
+
+// Remove all items from the buffer. The capacity is unchanged.
+func (buffer *RingBuffer[T]) Clear() {
+	clear(buffer.bufferData)
+	buffer.head = 0
+	buffer.size = 0
+}
+
+// ----------------------------------------------------------------------------
+// Apply, Map, and Fold methods
+//
+// Methods to apply a function across ALL items in a buffer.
+
+// Iterate over the buffer in the forward direction (oldest to newest) and apply a function to each item.
+//
+// Idiomatic Go should likely use ForwardIterator() rather than functional methods.
+//
+// It is expected that ForwardApply does *not* update the buffer items.
+// To modify the buffer items, use ForwardMap.
+// To accumulate values over the buffer, use ForwardFold.
+func ForwardApply[T any](buffer *RingBuffer[T], f func(item T)) {
+	for index := 0; index < buffer.size; index += 1 {
+		f(buffer.bufferData[buffer.dataIndex(index)])
+	}
+}
+
+// Iterate over the buffer in the forward direction (oldest to newest) and apply a function to each item
+// The result of this function is then assigned to the item at each step.
+//
+// Idiomatic Go should likely use ForwardIterator() rather than functional methods.
+//
+// ForwardMap can update the items by returning the update value.
+// If you do not need to modify the buffer items, use ForwardApply.
+// To accumulate values over the buffer, use ForwardFold.
+func ForwardMap[T any](buffer *RingBuffer[T], f func(item T) T) {
+	for index := 0; index < buffer.size; index += 1 {
+		dataIndex := buffer.dataIndex(index)
+		buffer.bufferData[dataIndex] = f(buffer.bufferData[dataIndex])
+	}
+}
+
+// Iterate over the buffer (oldest to newest) and apply the function f to it.
+// The function f also takes the current value of the accumulator.
+// The results of f become the new value of the accumulator at each step.
+//
+// This function returns the final accumulator.
+//
+// Idiomatic Go should likely use ForwardIterator() rather than functional methods.
+//
+// This function is not a method on RingBuffer to allow for generic accumulators.
+func ForwardFold[T any, G any](buffer *RingBuffer[T], initialAccumulator G, f func(item T, accumulator G) G) G {
+	accumulator := initialAccumulator
+	for index := 0; index < buffer.size; index += 1 {
+		accumulator = f(buffer.bufferData[buffer.dataIndex(index)], accumulator)
+	}
+
+	return accumulator
+}
+
+// Iterate over the buffer in the reverse direction (newest to oldest) and apply a function to each item.
+//
+// Idiomatic Go should likely use ReverseIterator() rather than functional methods.
+//
+// It is expected that ReverseApply does *not* update the buffer items.
+// To modify the buffer items, use ReverseMap.
+// To accumulate values over the buffer, use ReverseFold.
+func ReverseApply[T any](buffer *RingBuffer[T], f func(item T)) {
+	for index := buffer.size - 1; index >= 0; index -= 1 {
+		f(buffer.bufferData[buffer.dataIndex(index)])
+	}
+}
+
+// Iterate over the buffer in the reverse direction (newest to oldest) and apply a function to each item
+// The result of this function is then assigned to the item at each step.
+//
+// Idiomatic Go should likely use ReverseIterator() rather than functional methods.
+//
+// ReverseMap can update the items by returning the update value.
+// If you do not need to modify the buffer items, use ReverseApply.
+// To accumulate values over the buffer, use ReverseFold.
+func ReverseMap[T any](buffer *RingBuffer[T], f func(item T) T) {
+	for index := buffer.size - 1; index >= 0; index -= 1 {
+		dataIndex := buffer.dataIndex(index)
+		buffer.bufferData[dataIndex] = f(buffer.bufferData[dataIndex])
+	}
+}
+
+// Iterate over the buffer (newest to oldest) and apply the function f to it.
+// The function f also takes the current value of the accumulator.
+// The results of f become the new value of the accumulator at each step.
+//
+// This function returns the final accumulator.
+//
+// Idiomatic Go should likely use ReverseIterator() rather than functional methods.
+//
+// This function is not a method on RingBuffer to allow for generic accumulators.
+func ReverseFold[T any, G any](buffer *RingBuffer[T], initialAccumulator G, f func(item T, accumulator G) G) G {
+	accumulator := initialAccumulator
+	for index := buffer.size - 1; index >= 0; index -= 1 {
+		accumulator = f(buffer.bufferData[buffer.dataIndex(index)], accumulator)
+	}
+
+	return accumulator
+}
+
+// Iterate over the items of the buffer in the forward direction (oldest to newest).
+// Returns both the index (as counted from the oldest item) and item.
+// This method is not concurrency safe. For concurrent applications, consider using a mutex, or pull the data out using Items().
+func (buffer *RingBuffer[T]) ForwardIterator() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for index := 0; index < buffer.size; index += 1 {
+			item := buffer.bufferData[buffer.dataIndex(index)]
+			if !yield(index, item) {
+				break
+			}
+		}
+	}
+}
+
+// Iterate over the items of the buffer in the reverse direction (newest to oldest).
+// Returns both the index (as counted from the newest item) and item.
+// This method is not concurrency safe. For concurrent applications, consider using a mutex, or pull the data out using Items().
+func (buffer *RingBuffer[T]) ReverseIterator() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for index := 0; index < buffer.size; index += 1 {
+			item := buffer.bufferData[buffer.dataIndex(buffer.size-index-1)]
+			if !yield(index, item) {
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package ringbuffer_test
+
+import (
+	"slices"
+	"testing"
+
+	ringbuffer "github.com/hmcalister/Go-DSA/queue/RingBuffer"
+)
+
+// Create a full buffer holding "c", "d", "e", "f", with the oldest item in the middle of the backing array
+func newTestRingBuffer() *ringbuffer.RingBuffer[string] {
+	buffer, _ := ringbuffer.New[string](4, ringbuffer.OverwriteOldest)
+	for _, item := range []string{"a", "b", "c", "d", "e", "f"} {
+		buffer.Push(item)
+	}
+	return buffer
+}
+
+func TestRingBufferApply(t *testing.T) {
+	buffer := newTestRingBuffer()
+
+	forwardString := ""
+	ringbuffer.ForwardApply(buffer, func(item string) { forwardString += item })
+	if forwardString != "cdef" {
+		t.Errorf("result (%v) does not match expected result (%v)", forwardString, "cdef")
+	}
+
+	reverseString := ""
+	ringbuffer.ReverseApply(buffer, func(item string) { reverseString += item })
+	if reverseString != "fedc" {
+		t.Errorf("result (%v) does not match expected result (%v)", reverseString, "fedc")
+	}
+}
+
+func TestRingBufferMap(t *testing.T) {
+	buffer := newTestRingBuffer()
+
+	ringbuffer.ForwardMap(buffer, func(item string) string { return item + item })
+	ringbuffer.ReverseMap(buffer, func(item string) string { return item + "!" })
+
+	expectedItems := []string{"cc!", "dd!", "ee!", "ff!"}
+	if !slices.Equal(expectedItems, buffer.Items()) {
+		t.Errorf("found items %v do not match expected items %v", buffer.Items(), expectedItems)
+	}
+}
+
+func TestRingBufferFold(t *testing.T) {
+	buffer := newTestRingBuffer()
+
+	forwardString := ringbuffer.ForwardFold(buffer, "", func(item string, accumulator string) string { return accumulator + item })
+	if forwardString != "cdef" {
+		t.Errorf("result (%v) does not match expected result (%v)", forwardString, "cdef")
+	}
+
+	reverseString := ringbuffer.ReverseFold(buffer, "", func(item string, accumulator string) string { return accumulator + item })
+	if reverseString != "fedc" {
+		t.Errorf("result (%v) does not match expected result (%v)", reverseString, "fedc")
+	}
+}
+
+func TestRingBufferIterators(t *testing.T) {
+	buffer := newTestRingBuffer()
+
+	forwardString := ""
+	for index, item := range buffer.ForwardIterator() {
+		expectedItem, _ := buffer.At(index)
+		if item != expectedItem {
+			t.Errorf("forward iterator item (%v) at index %v does not match expected item (%v)", item, index, expectedItem)
+		}
+		forwardString += item
+	}
+	if forwardString != "cdef" {
+		t.Errorf("result (%v) does not match expected result (%v)", forwardString, "cdef")
+	}
+
+	reverseString := ""
+	for _, item := range buffer.ReverseIterator() {
+		reverseString += item
+	}
+	if reverseString != "fedc" {
+		t.Errorf("result (%v) does not match expected result (%v)", reverseString, "fedc")
+	}
+}
@@ -0,0 +1,163 @@
+package ringbuffer_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	ringbuffer "github.com/hmcalister/Go-DSA/queue/RingBuffer"
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
+)
+
+// ----------------------------------------------------------------------------
+// Initialization Tests
+
+func TestRingBufferInit(t *testing.T) {
+	t.Run("ring buffer int", func(t *testing.T) {
+		ringbuffer.New[int](5, ringbuffer.OverwriteOldest)
+	})
+	t.Run("ring buffer string", func(t *testing.T) {
+		ringbuffer.New[string](5, ringbuffer.RejectNew)
+	})
+	t.Run("ring buffer invalid capacity", func(t *testing.T) {
+		_, err := ringbuffer.New[int](0, ringbuffer.OverwriteOldest)
+		if !errors.Is(err, dsa_error.ErrorInvalidCapacity) {
+			t.Errorf("did not encounter expected error (%v) when creating buffer with invalid capacity, found %v", dsa_error.ErrorInvalidCapacity, err)
+		}
+	})
+}
+
+// ----------------------------------------------------------------------------
+// Misc Tests
+
+func TestRingBufferEmpty(t *testing.T) {
+	buffer, _ := ringbuffer.New[int](5, ringbuffer.OverwriteOldest)
+
+	if _, err := buffer.Peek(); !errors.Is(err, dsa_error.ErrorDataStructureEmpty) {
+		t.Errorf("did not encounter expected error (%v) when peeking at empty buffer, found %v", dsa_error.ErrorDataStructureEmpty, err)
+	}
+	if _, err := buffer.PeekNewest(); !errors.Is(err, dsa_error.ErrorDataStructureEmpty) {
+		t.Errorf("did not encounter expected error (%v) when peeking at empty buffer, found %v", dsa_error.ErrorDataStructureEmpty, err)
+	}
+	if _, err := buffer.Pop(); !errors.Is(err, dsa_error.ErrorDataStructureEmpty) {
+		t.Errorf("did not encounter expected error (%v) when popping from empty buffer, found %v", dsa_error.ErrorDataStructureEmpty, err)
+	}
+	if _, err := buffer.At(0); !errors.Is(err, dsa_error.ErrorIndexOutOfBounds) {
+		t.Errorf("did not encounter expected error (%v) when indexing empty buffer, found %v", dsa_error.ErrorIndexOutOfBounds, err)
+	}
+}
+
+func TestRingBufferOverwriteOldest(t *testing.T) {
+	buffer, _ := ringbuffer.New[int](3, ringbuffer.OverwriteOldest)
+
+	for _, item := range []int{1, 2, 3} {
+		if _, overwritten, err := buffer.Push(item); overwritten || err != nil {
+			t.Errorf("unexpected overwrite (%v) or error (%v) when pushing to non-full buffer", overwritten, err)
+		}
+	}
+	if !buffer.IsFull() {
+		t.Errorf("buffer claims to not be full with size %v", buffer.Size())
+	}
+
+	for _, item := range []int{4, 5} {
+		overwrittenItem, overwritten, err := buffer.Push(item)
+		if err != nil || !overwritten || overwrittenItem != item-3 {
+			t.Errorf("expected overwrite of item %v, found (%v, %v, %v)", item-3, overwrittenItem, overwritten, err)
+		}
+	}
+
+	expectedItems := []int{3, 4, 5}
+	if !slices.Equal(expectedItems, buffer.Items()) {
+		t.Errorf("found items %v do not match expected items %v", buffer.Items(), expectedItems)
+	}
+	for index, expectedItem := range expectedItems {
+		if item, err := buffer.At(index); err != nil || item != expectedItem {
+			t.Errorf("expected item %v at index %v, found (%v, %v)", expectedItem, index, item, err)
+		}
+	}
+	if item, _ := buffer.Peek(); item != 3 {
+		t.Errorf("found peek item (%v) does not match the expected item (%v)", item, 3)
+	}
+	if item, _ := buffer.PeekNewest(); item != 5 {
+		t.Errorf("found newest item (%v) does not match the expected item (%v)", item, 5)
+	}
+}
+
+func TestRingBufferRejectNew(t *testing.T) {
+	buffer, _ := ringbuffer.New[int](3, ringbuffer.RejectNew)
+
+	for _, item := range []int{1, 2, 3} {
+		buffer.Push(item)
+	}
+
+	if _, overwritten, err := buffer.Push(4); overwritten || !errors.Is(err, dsa_error.ErrorDataStructureFull) {
+		t.Errorf("did not encounter expected error (%v) when pushing to full buffer, found (%v, %v)", dsa_error.ErrorDataStructureFull, overwritten, err)
+	}
+
+	expectedItems := []int{1, 2, 3}
+	if !slices.Equal(expectedItems, buffer.Items()) {
+		t.Errorf("found items %v do not match expected items %v", buffer.Items(), expectedItems)
+	}
+
+	buffer.Pop()
+	if _, _, err := buffer.Push(4); err != nil {
+		t.Errorf("encountered error (%v) when pushing to non-full buffer", err)
+	}
+	expectedItems = []int{2, 3, 4}
+	if !slices.Equal(expectedItems, buffer.Items()) {
+		t.Errorf("found items %v do not match expected items %v", buffer.Items(), expectedItems)
+	}
+}
+
+func TestRingBufferPopOrder(t *testing.T) {
+	buffer, _ := ringbuffer.New[int](4, ringbuffer.OverwriteOldest)
+	for item := range 10 {
+		buffer.Push(item)
+	}
+
+	for _, expectedItem := range []int{6, 7, 8, 9} {
+		item, err := buffer.Pop()
+		if err != nil || item != expectedItem {
+			t.Errorf("expected to pop item %v, found (%v, %v)", expectedItem, item, err)
+		}
+	}
+	if buffer.Size() != 0 {
+		t.Errorf("found buffer size (%v) does not match the expected size (%v)", buffer.Size(), 0)
+	}
+}
+
+func TestRingBufferClear(t *testing.T) {
+	buffer, _ := ringbuffer.New[int](4, ringbuffer.OverwriteOldest)
+	for item := range 6 {
+		buffer.Push(item)
+	}
+
+	buffer.Clear()
+	if buffer.Size() != 0 || buffer.Capacity() != 4 {
+		t.Errorf("expected empty buffer of capacity 4 after clear, found size %v and capacity %v", buffer.Size(), buffer.Capacity())
+	}
+
+	buffer.Push(1)
+	if item, _ := buffer.Peek(); item != 1 {
+		t.Errorf("found peek item (%v) does not match the expected item (%v)", item, 1)
+	}
+}
+
+func TestRingBufferFind(t *testing.T) {
+	buffer, _ := ringbuffer.New[int](4, ringbuffer.OverwriteOldest)
+	for item := range 6 {
+		buffer.Push(item)
+	}
+
+	if item, err := buffer.Find(func(item int) bool { return item%2 == 1 }); err != nil || item != 3 {
+		t.Errorf("expected to find item 3, found (%v, %v)", item, err)
+	}
+	if _, err := buffer.Find(func(item int) bool { return item == 0 }); !errors.Is(err, dsa_error.ErrorItemNotFound) {
+		t.Errorf("did not encounter expected error (%v) when finding overwritten item, found %v", dsa_error.ErrorItemNotFound, err)
+	}
+
+	expectedItems := []int{2, 4}
+	if foundItems := buffer.FindAll(func(item int) bool { return item%2 == 0 }); !slices.Equal(expectedItems, foundItems) {
+		t.Errorf("found items %v do not match expected items %v", foundItems, expectedItems)
+	}
+}
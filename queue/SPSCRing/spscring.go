@@ -0,0 +1,119 @@
+package spscring
+
+import (
+	"math/bits"
+	"sync/atomic"
+
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
+)
+
+// The assumed size of a CPU cache line, used to pad frequently written fields
+// so that the producer and consumer do not contend on the same cache line (false sharing).
+const cacheLineSize = 64
+
+// Implement a bounded, wait-free, single-producer single-consumer (SPSC) ring buffer.
+//
+// Exactly one goroutine may call Add (the producer) and exactly one goroutine may call Remove (the consumer)
+// at any time. Under this restriction every operation completes in a bounded number of steps without locks.
+// Using more than one producer or more than one consumer concurrently is a data race.
+//
+// Items are removed in the order they were added (first in, first out).
+// Add and Remove never block: they return an error when the ring is full or empty respectively.
+type SPSCRing[T any] struct {
+	_ [cacheLineSize]byte
+
+	// The position of the next item to be removed. Written only by the consumer.
+	head atomic.Uint64
+	// The consumer's cached copy of tail, to avoid reading the producer's cache line on every Remove.
+	cachedTail uint64
+	_          [cacheLineSize - 16]byte
+
+	// The position of the next item to be added. Written only by the producer.
+	tail atomic.Uint64
+	// The producer's cached copy of head, to avoid reading the consumer's cache line on every Add.
+	cachedHead uint64
+	_          [cacheLineSize - 16]byte
+
+	// The circular buffer, with length a power of two
+	buffer []T
+
+	// len(buffer)-1, so that position&mask is the index of a position in the buffer
+	mask uint64
+}
+
+// Create a new SPSCRing with at least the given capacity.
+//
+// The capacity is rounded up to the next power of two (and is at least 2), which can be checked with Capacity().
+func New[T any](capacity int) *SPSCRing[T] {
+	roundedCapacity := uint64(1) << bits.Len(uint(max(2, capacity)-1))
+
+	return &SPSCRing[T]{
+		buffer: make([]T, roundedCapacity),
+		mask:   roundedCapacity - 1,
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Get Methods
+
+// Get the capacity of the ring, the maximum number of items the ring may hold.
+func (ring *SPSCRing[T]) Capacity() int {
+	return len(ring.buffer)
+}
+
+// Get the approximate size of the ring, the number of items in the ring.
+//
+// Since the producer and consumer may modify the ring concurrently, the size is only a snapshot
+// and may be out of date as soon as it is returned.
+func (ring *SPSCRing[T]) Size() int {
+	// Load the head first, so the difference is never negative
+	head := ring.head.Load()
+	tail := ring.tail.Load()
+	return int(min(tail-head, uint64(len(ring.buffer))))
+}
+
+// ----------------------------------------------------------------------------
+// Add Methods
+
+// Add an item to the back of the ring. Must only be called by the producer goroutine.
+//
+// Returns a dsa_error.ErrorDataStructureFull if the ring is full.
+func (ring *SPSCRing[T]) Add(item T) error {
+	tail := ring.tail.Load()
+	if tail-ring.cachedHead == uint64(len(ring.buffer)) {
+		// The ring looks full, refresh our view of the consumer's progress
+		ring.cachedHead = ring.head.Load()
+		if tail-ring.cachedHead == uint64(len(ring.buffer)) {
+			return dsa_error.ErrorDataStructureFull
+		}
+	}
+
+	// Write the item, then publish it to the consumer
+	ring.buffer[tail&ring.mask] = item
+	ring.tail.Store(tail + 1)
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Remove Methods
+
+// Remove and return the front item of the ring. Must only be called by the consumer goroutine.
+//
+// Returns a dsa_error.ErrorDataStructureEmpty if the ring is empty.
+func (ring *SPSCRing[T]) Remove() (T, error) {
+	head := ring.head.Load()
+	if head == ring.cachedTail {
+		// The ring looks empty, refresh our view of the producer's progress
+		ring.cachedTail = ring.tail.Load()
+		if head == ring.cachedTail {
+			return *new(T), dsa_error.ErrorDataStructureEmpty
+		}
+	}
+
+	// Read the item, then release the slot to the producer
+	index := head & ring.mask
+	item := ring.buffer[index]
+	ring.buffer[index] = *new(T)
+	ring.head.Store(head + 1)
+	return item, nil
+}
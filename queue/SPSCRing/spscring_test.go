@@ -0,0 +1,187 @@
+package spscring_test
+
+import (
+	"errors"
+	"runtime"
+	"sync"
+	"testing"
+
+	arrayqueue "github.com/hmcalister/Go-DSA/queue/ArrayQueue"
+	spscring "github.com/hmcalister/Go-DSA/queue/SPSCRing"
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
+)
+
+func TestSPSCRingInit(t *testing.T) {
+	t.Run("int", func(t *testing.T) {
+		spscring.New[int](8)
+	})
+	t.Run("float", func(t *testing.T) {
+		spscring.New[float64](8)
+	})
+	t.Run("string", func(t *testing.T) {
+		spscring.New[string](8)
+	})
+	t.Run("struct", func(t *testing.T) {
+		type S struct {
+			_ int
+			_ float64
+			_ string
+		}
+		spscring.New[S](8)
+	})
+}
+
+func TestSPSCRingCapacity(t *testing.T) {
+	testCases := []struct {
+		requested int
+		expected  int
+	}{
+		{0, 2},
+		{1, 2},
+		{3, 4},
+		{8, 8},
+		{100, 128},
+	}
+	for _, testCase := range testCases {
+		ring := spscring.New[int](testCase.requested)
+		if ring.Capacity() != testCase.expected {
+			t.Errorf("found capacity (%v) does not match expected capacity (%v) for requested capacity (%v)", ring.Capacity(), testCase.expected, testCase.requested)
+		}
+	}
+}
+
+func TestSPSCRingAddRemove(t *testing.T) {
+	ring := spscring.New[int](4)
+
+	if _, err := ring.Remove(); !errors.Is(err, dsa_error.ErrorDataStructureEmpty) {
+		t.Errorf("removing from empty ring gave unexpected error (%v)", err)
+	}
+
+	for lap := range 5 {
+		for i := range 4 {
+			if err := ring.Add(lap*10 + i); err != nil {
+				t.Fatalf("lap %v: failed to add item (%v): %v", lap, i, err)
+			}
+		}
+		if ring.Size() != 4 {
+			t.Errorf("found size (%v) does not match expected size (%v)", ring.Size(), 4)
+		}
+		if err := ring.Add(-1); !errors.Is(err, dsa_error.ErrorDataStructureFull) {
+			t.Errorf("adding to full ring gave unexpected error (%v)", err)
+		}
+
+		for i := range 4 {
+			item, err := ring.Remove()
+			if err != nil {
+				t.Fatalf("lap %v: failed to remove item: %v", lap, err)
+			}
+			if item != lap*10+i {
+				t.Errorf("found item (%v) does not match expected item (%v)", item, lap*10+i)
+			}
+		}
+		if _, err := ring.Remove(); !errors.Is(err, dsa_error.ErrorDataStructureEmpty) {
+			t.Errorf("removing from empty ring gave unexpected error (%v)", err)
+		}
+	}
+}
+
+// Stream items from one producer to one consumer through a small ring.
+// Every item must be received exactly once and in order.
+//
+// Run with -race to check the memory ordering of the ring.
+func TestSPSCRingConcurrentStress(t *testing.T) {
+	numItems := 500000
+	if testing.Short() {
+		numItems = 20000
+	}
+
+	ring := spscring.New[int](8)
+
+	var waitGroup sync.WaitGroup
+	waitGroup.Add(1)
+	go func() {
+		defer waitGroup.Done()
+		for item := range numItems {
+			for ring.Add(item) != nil {
+				runtime.Gosched()
+			}
+		}
+	}()
+
+	for expected := range numItems {
+		item, err := ring.Remove()
+		for err != nil {
+			runtime.Gosched()
+			item, err = ring.Remove()
+		}
+		if item != expected {
+			t.Fatalf("found item (%v) does not match expected item (%v)", item, expected)
+		}
+	}
+	waitGroup.Wait()
+
+	if ring.Size() != 0 {
+		t.Errorf("found size (%v) does not match expected size (%v)", ring.Size(), 0)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Benchmarks
+//
+// Compare the wait-free ring against an ArrayQueue guarded by a mutex, with one producer and one consumer.
+
+type mutexArrayQueue[T any] struct {
+	mutex     sync.Mutex
+	queueData *arrayqueue.ArrayQueue[T]
+}
+
+func (queue *mutexArrayQueue[T]) Add(item T) error {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	queue.queueData.Add(item)
+	return nil
+}
+
+func (queue *mutexArrayQueue[T]) Remove() (T, error) {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	return queue.queueData.Remove()
+}
+
+type benchmarkQueue interface {
+	Add(item int) error
+	Remove() (int, error)
+}
+
+func benchmarkProducerConsumer(b *testing.B, queue benchmarkQueue) {
+	var waitGroup sync.WaitGroup
+	waitGroup.Add(1)
+	go func() {
+		defer waitGroup.Done()
+		for item := range b.N {
+			for queue.Add(item) != nil {
+				runtime.Gosched()
+			}
+		}
+	}()
+
+	for range b.N {
+		for {
+			if _, err := queue.Remove(); err == nil {
+				break
+			}
+			runtime.Gosched()
+		}
+	}
+	waitGroup.Wait()
+}
+
+func BenchmarkSPSCRingProducerConsumer(b *testing.B) {
+	benchmarkProducerConsumer(b, spscring.New[int](1024))
+}
+
+func BenchmarkMutexArrayQueueProducerConsumer(b *testing.B) {
+	benchmarkProducerConsumer(b, &mutexArrayQueue[int]{queueData: arrayqueue.New[int]()})
+}
@@ -0,0 +1,166 @@
+package workstealingdeque
+
+import (
+	"sync/atomic"
+)
+
+// The assumed size of a CPU cache line, used to pad frequently written fields
+// so that the owner and thieves do not contend on the same cache line (false sharing).
+const cacheLineSize = 64
+
+// The initial capacity of the circular array. Must be a power of two.
+const initialCapacity = 32
+
+// A growable circular array of item pointers, indexed by the (unbounded) positions of the deque.
+//
+// Slots hold pointers so that thieves may read a slot concurrently with the owner writing another,
+// without a data race. Once published, an array is never resized, only replaced by grow.
+type circularArray[T any] struct {
+	slots []atomic.Pointer[T]
+	mask  int64
+}
+
+func newCircularArray[T any](capacity int64) *circularArray[T] {
+	return &circularArray[T]{
+		slots: make([]atomic.Pointer[T], capacity),
+		mask:  capacity - 1,
+	}
+}
+
+func (array *circularArray[T]) capacity() int64 {
+	return int64(len(array.slots))
+}
+
+func (array *circularArray[T]) slot(position int64) *atomic.Pointer[T] {
+	return &array.slots[position&array.mask]
+}
+
+// Create a new array of twice the capacity, holding the items at positions [top, bottom).
+func (array *circularArray[T]) grow(top, bottom int64) *circularArray[T] {
+	newArray := newCircularArray[T](2 * array.capacity())
+	for position := top; position < bottom; position += 1 {
+		newArray.slot(position).Store(array.slot(position).Load())
+	}
+	return newArray
+}
+
+// Implement a Chase-Lev work-stealing deque, as used by fork-join task schedulers.
+//
+// A single goroutine, the owner, pushes and pops items at the bottom of the deque (last in, first out).
+// Any number of other goroutines, the thieves, steal items from the top of the deque (first in, first out).
+// No operation takes a lock: the owner only contends with thieves when a single item remains.
+//
+// Push and Pop must only be called by the owner goroutine. Steal and Size may be called by any goroutine.
+//
+// The deque grows as needed and never shrinks.
+type WorkStealingDeque[T any] struct {
+	_ [cacheLineSize]byte
+
+	// The position of the next item to be stolen. Only ever increases.
+	top atomic.Int64
+	_   [cacheLineSize - 8]byte
+
+	// The position one past the most recently pushed item. Written only by the owner.
+	bottom atomic.Int64
+	_      [cacheLineSize - 8]byte
+
+	array atomic.Pointer[circularArray[T]]
+}
+
+// Create a new, empty WorkStealingDeque.
+func New[T any]() *WorkStealingDeque[T] {
+	deque := &WorkStealingDeque[T]{}
+	deque.array.Store(newCircularArray[T](initialCapacity))
+	return deque
+}
+
+// ----------------------------------------------------------------------------
+// Get Methods
+
+// Get the approximate size of the deque, the number of items in the deque.
+//
+// Since thieves may steal concurrently, the size is only a snapshot
+// and may be out of date as soon as it is returned.
+func (deque *WorkStealingDeque[T]) Size() int {
+	bottom := deque.bottom.Load()
+	top := deque.top.Load()
+	return int(max(0, bottom-top))
+}
+
+// ----------------------------------------------------------------------------
+// Add Methods
+
+// Push an item to the bottom of the deque. Must only be called by the owner goroutine.
+func (deque *WorkStealingDeque[T]) Push(item T) {
+	bottom := deque.bottom.Load()
+	top := deque.top.Load()
+	array := deque.array.Load()
+
+	if bottom-top >= array.capacity() {
+		array = array.grow(top, bottom)
+		deque.array.Store(array)
+	}
+
+	// Write the item, then publish it by moving the bottom
+	array.slot(bottom).Store(&item)
+	deque.bottom.Store(bottom + 1)
+}
+
+// ----------------------------------------------------------------------------
+// Remove Methods
+
+// Pop the item at the bottom of the deque, the most recently pushed item. Must only be called by the owner goroutine.
+//
+// Returns (item, true) if an item was popped, or (*new(T), false) if the deque is empty
+// (including if the last item was stolen concurrently).
+func (deque *WorkStealingDeque[T]) Pop() (T, bool) {
+	// Reserve the bottom item before looking at the top, so that thieves cannot also take it
+	bottom := deque.bottom.Load() - 1
+	array := deque.array.Load()
+	deque.bottom.Store(bottom)
+	top := deque.top.Load()
+
+	if top > bottom {
+		// The deque was empty, restore the bottom
+		deque.bottom.Store(bottom + 1)
+		return *new(T), false
+	}
+
+	slot := array.slot(bottom)
+	itemPointer := slot.Load()
+	if top == bottom {
+		// This is the last item, so race the thieves for it by claiming the top
+		won := deque.top.CompareAndSwap(top, top+1)
+		deque.bottom.Store(bottom + 1)
+		if !won {
+			return *new(T), false
+		}
+	}
+
+	// Release the slot so the item is not kept reachable. If the slot has since been reused, leave it be.
+	slot.CompareAndSwap(itemPointer, nil)
+	return *itemPointer, true
+}
+
+// Steal the item at the top of the deque, the least recently pushed item. May be called by any goroutine.
+//
+// If another goroutine takes the top item first, the steal is retried with the next item.
+//
+// Returns (item, true) if an item was stolen, or (*new(T), false) if the deque is empty.
+func (deque *WorkStealingDeque[T]) Steal() (T, bool) {
+	for {
+		top := deque.top.Load()
+		bottom := deque.bottom.Load()
+		if top >= bottom {
+			return *new(T), false
+		}
+
+		// Read the item before claiming it, since once claimed the owner may reuse the slot
+		slot := deque.array.Load().slot(top)
+		itemPointer := slot.Load()
+		if deque.top.CompareAndSwap(top, top+1) {
+			slot.CompareAndSwap(itemPointer, nil)
+			return *itemPointer, true
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package workstealingdeque_test
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	workstealingdeque "github.com/hmcalister/Go-DSA/queue/WorkStealingDeque"
+)
+
+// A minimal fork-join scheduler built on WorkStealingDeque, to demonstrate the intended use of the deque.
+//
+// Each worker owns a deque. A task may spawn subtasks onto its worker's deque.
+// Workers run their own tasks newest first (keeping the working set hot) and,
+// when out of work, steal the oldest tasks from other workers (which tend to be the largest).
+type exampleTask func(w *exampleWorker)
+
+type exampleScheduler struct {
+	workers []*exampleWorker
+
+	// The number of tasks spawned but not yet completed. The scheduler is finished once this reaches zero.
+	pendingTasks atomic.Int64
+}
+
+type exampleWorker struct {
+	id        int
+	scheduler *exampleScheduler
+	deque     *workstealingdeque.WorkStealingDeque[exampleTask]
+
+	tasksRun    int
+	tasksStolen int
+}
+
+func newExampleScheduler(numWorkers int) *exampleScheduler {
+	scheduler := &exampleScheduler{}
+	for id := range numWorkers {
+		scheduler.workers = append(scheduler.workers, &exampleWorker{
+			id:        id,
+			scheduler: scheduler,
+			deque:     workstealingdeque.New[exampleTask](),
+		})
+	}
+	return scheduler
+}
+
+// Run the root task to completion, along with all tasks it spawns.
+func (scheduler *exampleScheduler) run(root exampleTask) {
+	scheduler.workers[0].spawn(root)
+
+	var waitGroup sync.WaitGroup
+	for _, worker := range scheduler.workers {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			worker.run()
+		}()
+	}
+	waitGroup.Wait()
+}
+
+// Spawn a task onto this worker's deque. Must only be called from this worker's goroutine (or before it starts).
+func (worker *exampleWorker) spawn(task exampleTask) {
+	worker.scheduler.pendingTasks.Add(1)
+	worker.deque.Push(task)
+}
+
+func (worker *exampleWorker) run() {
+	numWorkers := len(worker.scheduler.workers)
+	for worker.scheduler.pendingTasks.Load() > 0 {
+		task, ok := worker.deque.Pop()
+		for offset := 1; !ok && offset < numWorkers; offset += 1 {
+			victim := worker.scheduler.workers[(worker.id+offset)%numWorkers]
+			task, ok = victim.deque.Steal()
+			if ok {
+				worker.tasksStolen += 1
+			}
+		}
+		if !ok {
+			runtime.Gosched()
+			continue
+		}
+
+		task(worker)
+		worker.tasksRun += 1
+		worker.scheduler.pendingTasks.Add(-1)
+	}
+}
+
+// Sum the integers in [low, high) by recursively splitting the range into subtasks.
+func parallelSumTask(low, high, threshold int, total *atomic.Int64, leaves *atomic.Int64) exampleTask {
+	return func(worker *exampleWorker) {
+		if high-low <= threshold {
+			sum := 0
+			for i := low; i < high; i += 1 {
+				sum += i
+			}
+			total.Add(int64(sum))
+			leaves.Add(1)
+			return
+		}
+
+		middle := low + (high-low)/2
+		worker.spawn(parallelSumTask(low, middle, threshold, total, leaves))
+		worker.spawn(parallelSumTask(middle, high, threshold, total, leaves))
+	}
+}
+
+func TestWorkStealingDequeExampleScheduler(t *testing.T) {
+	const numWorkers = 4
+	const threshold = 64
+	n := 1 << 20
+	if testing.Short() {
+		n = 1 << 16
+	}
+
+	scheduler := newExampleScheduler(numWorkers)
+	var total, leaves atomic.Int64
+	scheduler.run(parallelSumTask(0, n, threshold, &total, &leaves))
+
+	expectedTotal := int64(n) * int64(n-1) / 2
+	if total.Load() != expectedTotal {
+		t.Errorf("found total (%v) does not match expected total (%v)", total.Load(), expectedTotal)
+	}
+
+	// The range is a power of two, so splitting gives a perfect binary tree of tasks
+	expectedLeaves := int64(n / threshold)
+	if leaves.Load() != expectedLeaves {
+		t.Errorf("found leaves (%v) does not match expected leaves (%v)", leaves.Load(), expectedLeaves)
+	}
+
+	tasksRun := 0
+	for _, worker := range scheduler.workers {
+		tasksRun += worker.tasksRun
+		if worker.deque.Size() != 0 {
+			t.Errorf("worker (%v) finished with non-empty deque of size (%v)", worker.id, worker.deque.Size())
+		}
+	}
+	if int64(tasksRun) != 2*expectedLeaves-1 {
+		t.Errorf("found tasks run (%v) does not match expected tasks run (%v)", tasksRun, 2*expectedLeaves-1)
+	}
+}
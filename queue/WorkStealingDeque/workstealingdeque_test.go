@@ -0,0 +1,176 @@
+package workstealingdeque_test
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	workstealingdeque "github.com/hmcalister/Go-DSA/queue/WorkStealingDeque"
+)
+
+func TestWorkStealingDequeInit(t *testing.T) {
+	t.Run("int", func(t *testing.T) {
+		workstealingdeque.New[int]()
+	})
+	t.Run("float", func(t *testing.T) {
+		workstealingdeque.New[float64]()
+	})
+	t.Run("string", func(t *testing.T) {
+		workstealingdeque.New[string]()
+	})
+	t.Run("struct", func(t *testing.T) {
+		type S struct {
+			_ int
+			_ float64
+			_ string
+		}
+		workstealingdeque.New[S]()
+	})
+}
+
+func TestWorkStealingDequeEmpty(t *testing.T) {
+	deque := workstealingdeque.New[int]()
+	if item, ok := deque.Pop(); ok {
+		t.Errorf("popped item (%v) from empty deque", item)
+	}
+	if item, ok := deque.Steal(); ok {
+		t.Errorf("stole item (%v) from empty deque", item)
+	}
+	if deque.Size() != 0 {
+		t.Errorf("found size (%v) does not match expected size (%v)", deque.Size(), 0)
+	}
+}
+
+func TestWorkStealingDequePushPop(t *testing.T) {
+	// Push more items than the initial capacity, so the deque must grow
+	numItems := 1000
+	deque := workstealingdeque.New[int]()
+	for item := range numItems {
+		deque.Push(item)
+	}
+	if deque.Size() != numItems {
+		t.Errorf("found size (%v) does not match expected size (%v)", deque.Size(), numItems)
+	}
+
+	// The owner pops in last in, first out order
+	for expected := numItems - 1; expected >= 0; expected -= 1 {
+		item, ok := deque.Pop()
+		if !ok || item != expected {
+			t.Fatalf("found item (%v, %v) does not match expected item (%v, %v)", item, ok, expected, true)
+		}
+	}
+	if _, ok := deque.Pop(); ok {
+		t.Errorf("popped item from empty deque")
+	}
+}
+
+func TestWorkStealingDequeSteal(t *testing.T) {
+	numItems := 100
+	deque := workstealingdeque.New[int]()
+	for item := range numItems {
+		deque.Push(item)
+	}
+
+	// Thieves steal in first in, first out order
+	for expected := range numItems / 2 {
+		item, ok := deque.Steal()
+		if !ok || item != expected {
+			t.Fatalf("found item (%v, %v) does not match expected item (%v, %v)", item, ok, expected, true)
+		}
+	}
+	for expected := numItems - 1; expected >= numItems/2; expected -= 1 {
+		item, ok := deque.Pop()
+		if !ok || item != expected {
+			t.Fatalf("found item (%v, %v) does not match expected item (%v, %v)", item, ok, expected, true)
+		}
+	}
+	if _, ok := deque.Steal(); ok {
+		t.Errorf("stole item from empty deque")
+	}
+}
+
+func TestWorkStealingDequeWrapAround(t *testing.T) {
+	// Repeatedly push and steal so positions run well past the capacity without growing
+	deque := workstealingdeque.New[int]()
+	next := 0
+	expected := 0
+	for range 1000 {
+		for range 5 {
+			deque.Push(next)
+			next += 1
+		}
+		for range 5 {
+			item, ok := deque.Steal()
+			if !ok || item != expected {
+				t.Fatalf("found item (%v, %v) does not match expected item (%v, %v)", item, ok, expected, true)
+			}
+			expected += 1
+		}
+	}
+}
+
+// The owner pushes and pops while several thieves steal concurrently.
+// Every item must be taken exactly once, by either the owner or a thief.
+//
+// Run with -race to check the memory ordering of the deque.
+func TestWorkStealingDequeConcurrentStress(t *testing.T) {
+	const numThieves = 8
+	numItems := 200000
+	if testing.Short() {
+		numItems = 20000
+	}
+
+	deque := workstealingdeque.New[int]()
+	taken := make([]atomic.Int32, numItems)
+	var numTaken atomic.Int64
+	take := func(item int) {
+		if taken[item].Add(1) != 1 {
+			t.Errorf("item (%v) taken more than once", item)
+		}
+		numTaken.Add(1)
+	}
+
+	var waitGroup sync.WaitGroup
+	for range numThieves {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			for numTaken.Load() < int64(numItems) {
+				if item, ok := deque.Steal(); ok {
+					take(item)
+				} else {
+					runtime.Gosched()
+				}
+			}
+		}()
+	}
+
+	// The owner pushes in bursts and pops some items back, often contending for the last item
+	for item := 0; item < numItems; {
+		burst := min(item%7+1, numItems-item)
+		for range burst {
+			deque.Push(item)
+			item += 1
+		}
+		for range item % 3 {
+			if popped, ok := deque.Pop(); ok {
+				take(popped)
+			}
+		}
+	}
+	for {
+		popped, ok := deque.Pop()
+		if !ok {
+			break
+		}
+		take(popped)
+	}
+	waitGroup.Wait()
+
+	for item := range taken {
+		if taken[item].Load() != 1 {
+			t.Fatalf("item (%v) taken (%v) times", item, taken[item].Load())
+		}
+	}
+}
@@ -0,0 +1,219 @@
+package circularbuffer
+
+// The smallest capacity of the backing array, once any item has been added.
+// The backing array is never shrunk below this capacity.
+const minimumCapacity = 8
+
+// A growable array used as a circular buffer, shared by the array backed queues.
+//
+// Items are indexed from the front of the buffer. Adding an item to either end is amortized O(1),
+// as is removing an item from either end. The backing array doubles in size when full,
+// and halves in size when at most a quarter full.
+//
+// This type does no bounds checking. Callers must ensure indices are in [0, Size()),
+// and that the buffer is not empty before removing an item.
+type CircularBuffer[T any] struct {
+	// The backing array. The length of this slice is the capacity of the buffer.
+	//
+	// Items are stored from index head, wrapping around to the start of the slice.
+	// Slots not holding an item are always the zero value of T, so removed items are not kept reachable.
+	bufferData []T
+
+	// The index of the front item in bufferData.
+	head int
+
+	// The number of items in the buffer.
+	size int
+}
+
+// Create a new, empty CircularBuffer.
+func New[T any]() CircularBuffer[T] {
+	return CircularBuffer[T]{
+		// The backing array is only allocated once an item is added.
+		bufferData: make([]T, 0),
+		head:       0,
+		size:       0,
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helper Methods
+
+// Convert an index counted from the front of the buffer into an index of the backing array.
+func (buffer *CircularBuffer[T]) dataIndex(index int) int {
+	return (buffer.head + index) % len(buffer.bufferData)
+}
+
+// Move all items into a new backing array of the given capacity, with the front item at index 0.
+func (buffer *CircularBuffer[T]) resize(capacity int) {
+	newBufferData := make([]T, capacity)
+	if buffer.size > 0 {
+		// Copy the (up to) two contiguous runs of the circular buffer
+		firstRunEnd := min(buffer.head+buffer.size, len(buffer.bufferData))
+		copied := copy(newBufferData, buffer.bufferData[buffer.head:firstRunEnd])
+		copy(newBufferData[copied:], buffer.bufferData[:buffer.size-copied])
+	}
+	buffer.bufferData = newBufferData
+	buffer.head = 0
+}
+
+// Grow the backing array if there is no room for another item.
+func (buffer *CircularBuffer[T]) growIfFull() {
+	if buffer.size == len(buffer.bufferData) {
+		buffer.resize(max(minimumCapacity, 2*len(buffer.bufferData)))
+	}
+}
+
+// Shrink the backing array while it is mostly empty.
+// Shrinking at a quarter (rather than a half) avoids repeatedly resizing when the size oscillates around a boundary.
+func (buffer *CircularBuffer[T]) shrinkIfSparse() {
+	capacity := len(buffer.bufferData)
+	for capacity > minimumCapacity && buffer.size <= capacity/4 {
+		capacity = max(minimumCapacity, capacity/2)
+	}
+	if capacity != len(buffer.bufferData) {
+		buffer.resize(capacity)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Get and Set Methods
+
+// Get the number of items in the buffer.
+func (buffer *CircularBuffer[T]) Size() int {
+	return buffer.size
+}
+
+// Get the capacity of the backing array.
+func (buffer *CircularBuffer[T]) Capacity() int {
+	return len(buffer.bufferData)
+}
+
+// Get the item at the given index, counted from the front of the buffer.
+func (buffer *CircularBuffer[T]) At(index int) T {
+	return buffer.bufferData[buffer.dataIndex(index)]
+}
+
+// Set the item at the given index, counted from the front of the buffer.
+func (buffer *CircularBuffer[T]) Set(index int, item T) {
+	buffer.bufferData[buffer.dataIndex(index)] = item
+}
+
+// ----------------------------------------------------------------------------
+// Add Methods
+
+// Add an item to the front of the buffer, growing the backing array if needed.
+func (buffer *CircularBuffer[T]) PushFront(item T) {
+	buffer.growIfFull()
+
+	buffer.head = (buffer.head - 1 + len(buffer.bufferData)) % len(buffer.bufferData)
+	buffer.bufferData[buffer.head] = item
+	buffer.size += 1
+}
+
+// Add an item to the back of the buffer, growing the backing array if needed.
+func (buffer *CircularBuffer[T]) PushBack(item T) {
+	buffer.growIfFull()
+
+	buffer.bufferData[buffer.dataIndex(buffer.size)] = item
+	buffer.size += 1
+}
+
+// ----------------------------------------------------------------------------
+// Remove Methods
+
+// Remove and return the front item of the buffer, shrinking the backing array if it becomes sparse.
+func (buffer *CircularBuffer[T]) PopFront() T {
+	item := buffer.bufferData[buffer.head]
+
+	// Zero the slot so the removed item is not kept reachable by the backing array
+	buffer.bufferData[buffer.head] = *new(T)
+	buffer.head = (buffer.head + 1) % len(buffer.bufferData)
+	buffer.size -= 1
+
+	buffer.shrinkIfSparse()
+	return item
+}
+
+// Remove and return the back item of the buffer, shrinking the backing array if it becomes sparse.
+func (buffer *CircularBuffer[T]) PopBack() T {
+	backIndex := buffer.dataIndex(buffer.size - 1)
+	item := buffer.bufferData[backIndex]
+	buffer.bufferData[backIndex] = *new(T)
+	buffer.size -= 1
+
+	buffer.shrinkIfSparse()
+	return item
+}
+
+// Remove and return the item at the given index, shifting the items behind it forward by one.
+// Shrinks the backing array if it becomes sparse.
+func (buffer *CircularBuffer[T]) RemoveAt(index int) T {
+	item := buffer.At(index)
+	for shiftIndex := index; shiftIndex < buffer.size-1; shiftIndex += 1 {
+		buffer.Set(shiftIndex, buffer.At(shiftIndex+1))
+	}
+	buffer.Set(buffer.size-1, *new(T))
+	buffer.size -= 1
+
+	buffer.shrinkIfSparse()
+	return item
+}
+
+// Remove all items from the given index onwards, keeping the first size items.
+// Shrinks the backing array if it becomes sparse.
+func (buffer *CircularBuffer[T]) Truncate(size int) {
+	// Zero the vacated slots so the removed items are not kept reachable
+	for index := size; index < buffer.size; index += 1 {
+		buffer.Set(index, *new(T))
+	}
+	buffer.size = size
+
+	buffer.shrinkIfSparse()
+}
+
+// ----------------------------------------------------------------------------
+// Rotate Methods
+
+// Rotate the buffer n steps to the right (towards the back).
+// Rotating one step to the right moves the back item to the front.
+// If n is negative, rotate to the left instead, moving the front item to the back.
+//
+// Rotating takes O(min(k, size-k)) time, where k is the number of steps modulo the size of the buffer.
+func (buffer *CircularBuffer[T]) Rotate(n int) {
+	if buffer.size <= 1 {
+		return
+	}
+
+	// Normalize the rotation to a number of steps to the right in [0, size)
+	steps := n % buffer.size
+	if steps < 0 {
+		steps += buffer.size
+	}
+	if steps == 0 {
+		return
+	}
+
+	// If the backing array is full, rotation is simply a change of head
+	if buffer.size == len(buffer.bufferData) {
+		buffer.head = buffer.dataIndex(buffer.size - steps)
+		return
+	}
+
+	// Otherwise move items one at a time, in whichever direction moves fewer items
+	if steps <= buffer.size/2 {
+		for range steps {
+			backIndex := buffer.dataIndex(buffer.size - 1)
+			buffer.head = (buffer.head - 1 + len(buffer.bufferData)) % len(buffer.bufferData)
+			buffer.bufferData[buffer.head] = buffer.bufferData[backIndex]
+			buffer.bufferData[backIndex] = *new(T)
+		}
+	} else {
+		for range buffer.size - steps {
+			frontIndex := buffer.head
+			buffer.bufferData[buffer.dataIndex(buffer.size)] = buffer.bufferData[frontIndex]
+			buffer.bufferData[frontIndex] = *new(T)
+			buffer.head = (buffer.head + 1) % len(buffer.bufferData)
+		}
+	}
+}
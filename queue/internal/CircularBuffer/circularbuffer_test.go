@@ -0,0 +1,83 @@
+package circularbuffer_test
+
+import (
+	"slices"
+	"testing"
+
+	circularbuffer "github.com/hmcalister/Go-DSA/queue/internal/CircularBuffer"
+)
+
+// a helper method to check the items of a buffer, front first.
+//
+// calls t.Fatalf if the buffer does not hold exactly the expected items.
+func checkCircularBufferItems(t *testing.T, buffer *circularbuffer.CircularBuffer[int], expectedItems []int) {
+	t.Helper()
+
+	items := make([]int, buffer.Size())
+	for index := range items {
+		items[index] = buffer.At(index)
+	}
+	if !slices.Equal(items, expectedItems) {
+		t.Fatalf("found items %v do not match expected items %v", items, expectedItems)
+	}
+}
+
+func TestCircularBufferGrowAndShrink(t *testing.T) {
+	buffer := circularbuffer.New[int]()
+	if buffer.Capacity() != 0 {
+		t.Errorf("found capacity (%v) does not match expected capacity (%v)", buffer.Capacity(), 0)
+	}
+
+	for item := range 64 {
+		buffer.PushBack(item)
+	}
+	if buffer.Capacity() != 64 {
+		t.Errorf("found capacity (%v) does not match expected capacity (%v)", buffer.Capacity(), 64)
+	}
+
+	// Removing down to a quarter of the capacity halves the backing array
+	for range 48 {
+		buffer.PopFront()
+	}
+	if buffer.Capacity() != 32 {
+		t.Errorf("found capacity (%v) does not match expected capacity (%v)", buffer.Capacity(), 32)
+	}
+
+	// Truncating to a single item shrinks repeatedly, down to the minimum capacity
+	buffer.Truncate(1)
+	if buffer.Capacity() != 8 {
+		t.Errorf("found capacity (%v) does not match expected capacity (%v)", buffer.Capacity(), 8)
+	}
+	checkCircularBufferItems(t, &buffer, []int{48})
+}
+
+func TestCircularBufferWrapAround(t *testing.T) {
+	buffer := circularbuffer.New[int]()
+
+	// Push to the front of an empty buffer, so the items wrap around the end of the backing array
+	for item := range 4 {
+		buffer.PushFront(item)
+	}
+	for item := 4; item < 8; item += 1 {
+		buffer.PushBack(item)
+	}
+	checkCircularBufferItems(t, &buffer, []int{3, 2, 1, 0, 4, 5, 6, 7})
+
+	if item := buffer.RemoveAt(2); item != 1 {
+		t.Errorf("found removed item (%v) does not match expected item (%v)", item, 1)
+	}
+	checkCircularBufferItems(t, &buffer, []int{3, 2, 0, 4, 5, 6, 7})
+
+	buffer.Rotate(2)
+	checkCircularBufferItems(t, &buffer, []int{6, 7, 3, 2, 0, 4, 5})
+	buffer.Rotate(-3)
+	checkCircularBufferItems(t, &buffer, []int{2, 0, 4, 5, 6, 7, 3})
+
+	if item := buffer.PopBack(); item != 3 {
+		t.Errorf("found removed item (%v) does not match expected item (%v)", item, 3)
+	}
+	if item := buffer.PopFront(); item != 2 {
+		t.Errorf("found removed item (%v) does not match expected item (%v)", item, 2)
+	}
+	checkCircularBufferItems(t, &buffer, []int{0, 4, 5, 6, 7})
+}
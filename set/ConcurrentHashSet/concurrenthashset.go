@@ -0,0 +1,162 @@
+package concurrenthashset
+
+import (
+	"iter"
+
+	concurrenthashmap "github.com/hmcalister/Go-DSA/map/ConcurrentHashMap"
+	hasher "github.com/hmcalister/Go-DSA/utils/Hasher"
+)
+
+// An implementation of a set safe for concurrent use, split by hash into independently locked shards.
+//
+// This set shares the basic methods of github.com/hmcalister/Go-DSA/set/HashSet (Add, Contains, Remove, Size, Items, and Iterator),
+// but not the set algebra methods. It is backed by github.com/hmcalister/Go-DSA/map/ConcurrentHashMap,
+// so goroutines using items of different shards never block one another.
+// Add, Contains, Remove, and Compute are atomic. Size, Items, and Iterator visit the shards one at a time, so are not atomic.
+//
+// A ConcurrentHashSet is safe for concurrent use by any number of goroutines.
+type ConcurrentHashSet[T comparable] struct {
+	setData *concurrenthashmap.ConcurrentHashMap[T, struct{}]
+}
+
+// Create a new ConcurrentHashSet with at least the given number of shards.
+// The hash function assigns items to shards, and must give equal hashes for equal items.
+//
+// The number of shards is rounded up to the next power of two (and is at least 1), which can be checked with ShardCount().
+func New[T comparable](numShards int, hashFunction hasher.HashFunction[T]) *ConcurrentHashSet[T] {
+	return &ConcurrentHashSet[T]{
+		setData: concurrenthashmap.New[T, struct{}](numShards, hashFunction),
+	}
+}
+
+// Create a new ConcurrentHashSet containing the given items. Duplicate items are added only once.
+func NewFromSlice[T comparable](items []T, numShards int, hashFunction hasher.HashFunction[T]) *ConcurrentHashSet[T] {
+	set := New(numShards, hashFunction)
+	for _, item := range items {
+		set.Add(item)
+	}
+	return set
+}
+
+// Create a new ConcurrentHashSet containing the items of a sequence. Duplicate items are added only once.
+func NewFromSeq[T comparable](seq iter.Seq[T], numShards int, hashFunction hasher.HashFunction[T]) *ConcurrentHashSet[T] {
+	set := New(numShards, hashFunction)
+	for item := range seq {
+		set.Add(item)
+	}
+	return set
+}
+
+// Get the number of shards of the set.
+func (set *ConcurrentHashSet[T]) ShardCount() int {
+	return set.setData.ShardCount()
+}
+
+// Return the size of the set, the number of items contained.
+//
+// The shards are counted one at a time, so if other goroutines modify the set concurrently
+// the size is only approximate, and may be out of date as soon as it is returned.
+func (set *ConcurrentHashSet[T]) Size() int {
+	return set.setData.Size()
+}
+
+// Add an item to the set. Returns true if the item was *not* already present.
+//
+// Checking and adding the item is one atomic operation, so when many goroutines add the same item, exactly one is returned true.
+func (set *ConcurrentHashSet[T]) Add(item T) bool {
+	_, loaded := set.setData.LoadOrStore(item, struct{}{})
+	return !loaded
+}
+
+// Checks if an item is already present in the set.
+func (set *ConcurrentHashSet[T]) Contains(item T) bool {
+	return set.setData.ContainsKey(item)
+}
+
+// Remove an item from the set. Returns an error if the item is not contained in the set.
+func (set *ConcurrentHashSet[T]) Remove(item T) error {
+	if _, err := set.setData.Remove(item); err != nil {
+		return ErrorItemNotContained
+	}
+	return nil
+}
+
+// Decide whether an item should be present in the set, as one atomic operation.
+//
+// The function f is given whether the item is currently present, and returns whether the item should be present afterwards.
+// Returns whether the item is present after the update.
+//
+// f is called while holding the lock of the shard of the item, so f should be quick, and must not use this set,
+// otherwise it may deadlock.
+func (set *ConcurrentHashSet[T]) Compute(item T, f func(present bool) bool) bool {
+	_, present := set.setData.Compute(item, func(_ struct{}, present bool) (struct{}, bool) {
+		return struct{}{}, f(present)
+	})
+	return present
+}
+
+// Remove all items from the set.
+//
+// The shards are cleared one at a time, so items added concurrently may remain.
+func (set *ConcurrentHashSet[T]) Clear() {
+	set.setData.Clear()
+}
+
+// Get all items from the set. This method allocates an array of length equal to the number of items.
+// The items are not guaranteed to be in the order they were inserted into the set.
+//
+// The shards are visited one at a time, as described in Iterator().
+func (set *ConcurrentHashSet[T]) Items() []T {
+	return set.setData.Keys()
+}
+
+// Iterate over the items of the set and apply a function to each item.
+//
+// Idiomatic Go should likely use Iterator() rather than functional methods.
+//
+// BEWARE: Iteration over a hashset does not guarantee a specific order ---
+// you may find elements in any order, not the order they were inserted!
+// Ensure your function accounts for this.
+//
+// To accumulate values over items, use Fold.
+func Apply[T comparable](set *ConcurrentHashSet[T], f func(item T)) {
+	for item := range set.Iterator() {
+		f(item)
+	}
+}
+
+// Iterate over set items and apply the function f.
+// The function f also takes the current value of the accumulator.
+// The results of f become the new value of the accumulator at each step.
+//
+// Idiomatic Go should likely use Iterator() rather than functional methods.
+//
+// BEWARE: Iteration over a hashset does not guarantee a specific order ---
+// you may find elements in any order, not the order they were inserted!
+// Ensure your function accounts for this. This is especially important for
+// a fold!
+//
+// This function is not a method on ConcurrentHashSet to allow for generic accumulators.
+func Fold[T comparable, G any](set *ConcurrentHashSet[T], initialAccumulator G, f func(item T, accumulator G) G) G {
+	accumulator := initialAccumulator
+	for item := range set.Iterator() {
+		accumulator = f(item, accumulator)
+	}
+
+	return accumulator
+}
+
+// Iterate over the items of the set. Note the iteration order may not be the insertion order.
+//
+// This method is safe to use while other goroutines modify the set. Each item is yielded at most once,
+// and every item present for the whole iteration is yielded, while items added or removed concurrently may or may not be seen.
+// See github.com/hmcalister/Go-DSA/map/ConcurrentHashMap for details.
+func (set *ConcurrentHashSet[T]) Iterator() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for item := range set.setData.Iterator() {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
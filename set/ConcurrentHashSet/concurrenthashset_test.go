@@ -0,0 +1,163 @@
+package concurrenthashset_test
+
+import (
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+
+	concurrenthashset "github.com/hmcalister/Go-DSA/set/ConcurrentHashSet"
+	hasher "github.com/hmcalister/Go-DSA/utils/Hasher"
+)
+
+// a helper method to check the items of a set, in any order.
+//
+// calls t.Errorf if the set does not contain exactly the expected items.
+func checkConcurrentHashSetItems(t *testing.T, set *concurrenthashset.ConcurrentHashSet[int], expectedItems []int) {
+	t.Helper()
+
+	items := slices.Sorted(set.Iterator())
+	expectedItems = slices.Sorted(slices.Values(expectedItems))
+	if !slices.Equal(items, expectedItems) {
+		t.Errorf("found items (%v) does not match expected items (%v)", items, expectedItems)
+	}
+	if set.Size() != len(expectedItems) {
+		t.Errorf("found size (%v) does not match expected size (%v)", set.Size(), len(expectedItems))
+	}
+}
+
+func TestConcurrentHashSetInit(t *testing.T) {
+	t.Run("int", func(t *testing.T) {
+		concurrenthashset.New[int](16, hasher.DefaultIntegerHashFunction)
+	})
+	t.Run("float", func(t *testing.T) {
+		concurrenthashset.New[float64](16, hasher.DefaultFloat64HashFunction)
+	})
+	t.Run("string", func(t *testing.T) {
+		concurrenthashset.New[string](16, hasher.DefaultStringHashFunction)
+	})
+	t.Run("struct", func(t *testing.T) {
+		type S struct {
+			i int
+			_ float64
+			_ string
+		}
+		concurrenthashset.New[S](16, func(item S) uint64 { return uint64(item.i) })
+	})
+	t.Run("from slice", func(t *testing.T) {
+		set := concurrenthashset.NewFromSlice([]int{3, 1, 3, 2}, 5, hasher.DefaultIntegerHashFunction)
+		checkConcurrentHashSetItems(t, set, []int{1, 2, 3})
+		if set.ShardCount() != 8 {
+			t.Errorf("found shard count (%v) does not match expected shard count (%v)", set.ShardCount(), 8)
+		}
+	})
+	t.Run("from seq", func(t *testing.T) {
+		set := concurrenthashset.NewFromSeq(slices.Values([]int{3, 1, 3, 2}), 4, hasher.DefaultIntegerHashFunction)
+		checkConcurrentHashSetItems(t, set, []int{1, 2, 3})
+
+		// A set can be constructed from the iterator of another set
+		copiedSet := concurrenthashset.NewFromSeq(set.Iterator(), 4, hasher.DefaultIntegerHashFunction)
+		checkConcurrentHashSetItems(t, copiedSet, []int{1, 2, 3})
+	})
+}
+
+func TestConcurrentHashSetAddContainsRemove(t *testing.T) {
+	set := concurrenthashset.New[int](4, hasher.DefaultIntegerHashFunction)
+	if !set.Add(1) || !set.Add(2) {
+		t.Errorf("expected adding new item to return true")
+	}
+	if set.Add(1) {
+		t.Errorf("expected adding present item to return false")
+	}
+	if !set.Contains(1) || set.Contains(3) {
+		t.Errorf("found unexpected result from Contains")
+	}
+	checkConcurrentHashSetItems(t, set, []int{1, 2})
+
+	if err := set.Remove(1); err != nil {
+		t.Errorf("error when removing present item: %v", err)
+	}
+	if err := set.Remove(1); !errors.Is(err, concurrenthashset.ErrorItemNotContained) {
+		t.Errorf("expected ErrorItemNotContained when removing missing item, found (%v)", err)
+	}
+	checkConcurrentHashSetItems(t, set, []int{2})
+
+	set.Clear()
+	checkConcurrentHashSetItems(t, set, []int{})
+}
+
+func TestConcurrentHashSetCompute(t *testing.T) {
+	set := concurrenthashset.New[int](4, hasher.DefaultIntegerHashFunction)
+	toggle := func(present bool) bool { return !present }
+
+	if !set.Compute(1, toggle) {
+		t.Errorf("expected toggling missing item to add it")
+	}
+	set.Compute(2, toggle)
+	if set.Compute(1, toggle) {
+		t.Errorf("expected toggling present item to remove it")
+	}
+	checkConcurrentHashSetItems(t, set, []int{2})
+}
+
+func TestConcurrentHashSetApplyFold(t *testing.T) {
+	set := concurrenthashset.NewFromSlice([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, 4, hasher.DefaultIntegerHashFunction)
+
+	sum := 0
+	concurrenthashset.Apply(set, func(item int) { sum += item })
+	if sum != 55 {
+		t.Errorf("result (%v) does not match expected result (%v)", sum, 55)
+	}
+
+	foldedSum := concurrenthashset.Fold(set, 0, func(item int, accumulator int) int { return accumulator + item })
+	if foldedSum != 55 {
+		t.Errorf("result (%v) does not match expected result (%v)", foldedSum, 55)
+	}
+
+	items := set.Items()
+	slices.Sort(items)
+	if !slices.Equal(items, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}) {
+		t.Errorf("found items (%v) does not match expected items (%v)", items, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10})
+	}
+}
+
+// Run with -race to check the locking of the set.
+//
+// Goroutines add and remove overlapping ranges of items. Each successful Add must be matched by at most one successful Remove.
+func TestConcurrentHashSetConcurrentAddRemove(t *testing.T) {
+	const numGoroutines = 16
+	numItems := 2000
+	if testing.Short() {
+		numItems = 200
+	}
+
+	set := concurrenthashset.New[int](8, hasher.DefaultIntegerHashFunction)
+	addedCounts := make([]int, numGoroutines)
+	removedCounts := make([]int, numGoroutines)
+	var waitGroup sync.WaitGroup
+	for goroutine := range numGoroutines {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			for item := range numItems {
+				if set.Add(item) {
+					addedCounts[goroutine] += 1
+				}
+				set.Contains(item)
+				if goroutine%2 == 0 && set.Remove(item) == nil {
+					removedCounts[goroutine] += 1
+				}
+			}
+		}()
+	}
+	waitGroup.Wait()
+
+	totalAdded, totalRemoved := 0, 0
+	for goroutine := range numGoroutines {
+		totalAdded += addedCounts[goroutine]
+		totalRemoved += removedCounts[goroutine]
+	}
+	if totalAdded-totalRemoved != set.Size() {
+		t.Errorf("found size (%v) does not match added (%v) minus removed (%v) items", set.Size(), totalAdded, totalRemoved)
+	}
+}
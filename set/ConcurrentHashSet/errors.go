@@ -0,0 +1,7 @@
+package concurrenthashset
+
+import "errors"
+
+var (
+	ErrorItemNotContained = errors.New("item not in set")
+)
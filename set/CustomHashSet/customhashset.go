@@ -0,0 +1,281 @@
+package customhashset
+
+import (
+	"iter"
+
+	customhashmap "github.com/hmcalister/Go-DSA/map/CustomHashMap"
+	hasher "github.com/hmcalister/Go-DSA/utils/Hasher"
+)
+
+// An implementation of a set for items that are not comparable, or that need custom equality,
+// using a user supplied hash function and equality function.
+//
+// This set has the same API as github.com/hmcalister/Go-DSA/set/HashSet, but is backed by
+// github.com/hmcalister/Go-DSA/map/CustomHashMap rather than a Go map, so it can hold slices
+// (or structs containing slices), or items with custom equality such as case insensitive strings.
+type CustomHashSet[T any] struct {
+	setData *customhashmap.CustomHashMap[T, struct{}]
+
+	hashFunction     hasher.HashFunction[T]
+	equalityFunction hasher.EqualityFunction[T]
+}
+
+// Create a new CustomHashSet.
+//
+// The hash function and equality function must agree: items that are equal must have equal hashes.
+func New[T any](hashFunction hasher.HashFunction[T], equalityFunction hasher.EqualityFunction[T]) *CustomHashSet[T] {
+	return &CustomHashSet[T]{
+		setData:          customhashmap.New[T, struct{}](hashFunction, equalityFunction),
+		hashFunction:     hashFunction,
+		equalityFunction: equalityFunction,
+	}
+}
+
+// Create a new CustomHashSet containing the given items. Duplicate items are added only once.
+func NewFromSlice[T any](items []T, hashFunction hasher.HashFunction[T], equalityFunction hasher.EqualityFunction[T]) *CustomHashSet[T] {
+	set := New(hashFunction, equalityFunction)
+	for _, item := range items {
+		set.Add(item)
+	}
+	return set
+}
+
+// Create a new CustomHashSet containing the items of a sequence. Duplicate items are added only once.
+func NewFromSeq[T any](seq iter.Seq[T], hashFunction hasher.HashFunction[T], equalityFunction hasher.EqualityFunction[T]) *CustomHashSet[T] {
+	set := New(hashFunction, equalityFunction)
+	for item := range seq {
+		set.Add(item)
+	}
+	return set
+}
+
+// Create a new, empty set with the same hash and equality functions as this set.
+func (set *CustomHashSet[T]) newEmpty() *CustomHashSet[T] {
+	return New(set.hashFunction, set.equalityFunction)
+}
+
+// Return the size of the set, the number of items contained.
+func (set *CustomHashSet[T]) Size() int {
+	return set.setData.Size()
+}
+
+// Add an item to the set. Returns true if the item was *not* already present.
+//
+// If an equal item is already present, the item already in the set is kept.
+func (set *CustomHashSet[T]) Add(item T) bool {
+	_, replaced := set.setData.Put(item, struct{}{})
+	return !replaced
+}
+
+// Checks if an item is already present in the set.
+func (set *CustomHashSet[T]) Contains(item T) bool {
+	return set.setData.ContainsKey(item)
+}
+
+// Remove an item from the set. Returns an error if the item is not contained in the set.
+func (set *CustomHashSet[T]) Remove(item T) error {
+	if _, err := set.setData.Remove(item); err != nil {
+		return ErrorItemNotContained
+	}
+	return nil
+}
+
+// Get all items from the set. This method allocates an array of length equal to the number of items.
+// The items are not guaranteed to be in the order they were inserted into the set.
+func (set *CustomHashSet[T]) Items() []T {
+	return set.setData.Keys()
+}
+
+// Iterate over the items of the set and apply a function to each item.
+//
+// Idiomatic Go should likely use Iterator() rather than functional methods.
+//
+// BEWARE: Iteration over a hashset does not guarantee a specific order ---
+// you may find elements in any order, not the order they were inserted!
+// Ensure your function accounts for this.
+//
+// To accumulate values over items, use Fold.
+func Apply[T any](set *CustomHashSet[T], f func(item T)) {
+	for item := range set.Iterator() {
+		f(item)
+	}
+}
+
+// Iterate over set items and apply the function f.
+// The function f also takes the current value of the accumulator.
+// The results of f become the new value of the accumulator at each step.
+//
+// Idiomatic Go should likely use Iterator() rather than functional methods.
+//
+// BEWARE: Iteration over a hashset does not guarantee a specific order ---
+// you may find elements in any order, not the order they were inserted!
+// Ensure your function accounts for this. This is especially important for
+// a fold!
+//
+// This function is not a method on CustomHashSet to allow for generic accumulators.
+func Fold[T any, G any](set *CustomHashSet[T], initialAccumulator G, f func(item T, accumulator G) G) G {
+	accumulator := initialAccumulator
+	for item := range set.Iterator() {
+		accumulator = f(item, accumulator)
+	}
+
+	return accumulator
+}
+
+// Iterate over the items of the set. Note the iteration order may not be the insertion order.
+// This method is not concurrency safe. For concurrent applications, consider using a mutex, or pull the data out using Items().
+func (set *CustomHashSet[T]) Iterator() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for item := range set.setData.Iterator() {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Set algebra methods
+//
+// Methods combining or comparing two sets. Methods such as Union return a new set and leave both sets unchanged,
+// while methods such as UnionWith update the receiving set in place.
+//
+// Both sets are expected to use the same hash and equality functions. New sets use the functions of the receiving set.
+
+// Create a new set containing the items in either this set or other (or both).
+func (set *CustomHashSet[T]) Union(other *CustomHashSet[T]) *CustomHashSet[T] {
+	result := set.newEmpty()
+	result.UnionWith(set)
+	result.UnionWith(other)
+	return result
+}
+
+// Add all items of other to this set, in place.
+func (set *CustomHashSet[T]) UnionWith(other *CustomHashSet[T]) {
+	if set == other {
+		return
+	}
+
+	for item := range other.Iterator() {
+		set.Add(item)
+	}
+}
+
+// Create a new set containing the items in both this set and other.
+//
+// The smaller of the two sets is iterated, so this is O(min(n, m)).
+func (set *CustomHashSet[T]) Intersection(other *CustomHashSet[T]) *CustomHashSet[T] {
+	smaller, larger := set, other
+	if larger.Size() < smaller.Size() {
+		smaller, larger = larger, smaller
+	}
+
+	result := set.newEmpty()
+	for item := range smaller.Iterator() {
+		if larger.Contains(item) {
+			result.Add(item)
+		}
+	}
+	return result
+}
+
+// Remove all items from this set that are not in other, in place.
+//
+// The smaller of the two sets is iterated, so this is O(min(n, m)).
+func (set *CustomHashSet[T]) IntersectWith(other *CustomHashSet[T]) {
+	if set == other {
+		return
+	}
+
+	// The table cannot be modified while iterating, so the intersection is built separately
+	set.setData = set.Intersection(other).setData
+}
+
+// Create a new set containing the items in this set that are not in other.
+func (set *CustomHashSet[T]) Difference(other *CustomHashSet[T]) *CustomHashSet[T] {
+	result := set.newEmpty()
+	for item := range set.Iterator() {
+		if !other.Contains(item) {
+			result.Add(item)
+		}
+	}
+	return result
+}
+
+// Remove all items in other from this set, in place.
+func (set *CustomHashSet[T]) DifferenceWith(other *CustomHashSet[T]) {
+	if set == other {
+		set.setData.Clear()
+		return
+	}
+
+	for item := range other.Iterator() {
+		set.setData.Remove(item)
+	}
+}
+
+// Create a new set containing the items in exactly one of this set and other.
+func (set *CustomHashSet[T]) SymmetricDifference(other *CustomHashSet[T]) *CustomHashSet[T] {
+	result := set.Difference(other)
+	for item := range other.Iterator() {
+		if !set.Contains(item) {
+			result.Add(item)
+		}
+	}
+	return result
+}
+
+// Update this set to contain the items in exactly one of this set and other, in place.
+func (set *CustomHashSet[T]) SymmetricDifferenceWith(other *CustomHashSet[T]) {
+	if set == other {
+		set.setData.Clear()
+		return
+	}
+
+	for item := range other.Iterator() {
+		if _, err := set.setData.Remove(item); err != nil {
+			set.Add(item)
+		}
+	}
+}
+
+// Determine if every item of this set is also in other.
+func (set *CustomHashSet[T]) IsSubsetOf(other *CustomHashSet[T]) bool {
+	if set.Size() > other.Size() {
+		return false
+	}
+
+	for item := range set.Iterator() {
+		if !other.Contains(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// Determine if every item of other is also in this set.
+func (set *CustomHashSet[T]) IsSupersetOf(other *CustomHashSet[T]) bool {
+	return other.IsSubsetOf(set)
+}
+
+// Determine if this set and other have no items in common.
+//
+// The smaller of the two sets is iterated, so this is O(min(n, m)).
+func (set *CustomHashSet[T]) IsDisjoint(other *CustomHashSet[T]) bool {
+	smaller, larger := set, other
+	if larger.Size() < smaller.Size() {
+		smaller, larger = larger, smaller
+	}
+
+	for item := range smaller.Iterator() {
+		if larger.Contains(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// Determine if this set and other contain exactly the same items.
+func (set *CustomHashSet[T]) Equal(other *CustomHashSet[T]) bool {
+	return set.Size() == other.Size() && set.IsSubsetOf(other)
+}
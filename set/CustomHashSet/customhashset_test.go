@@ -0,0 +1,181 @@
+package customhashset_test
+
+import (
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+
+	customhashset "github.com/hmcalister/Go-DSA/set/CustomHashSet"
+	hashset "github.com/hmcalister/Go-DSA/set/HashSet"
+	hasher "github.com/hmcalister/Go-DSA/utils/Hasher"
+)
+
+func newIntegerSet(items []int) *customhashset.CustomHashSet[int] {
+	return customhashset.NewFromSlice(items, hasher.DefaultIntegerHashFunction, hasher.DefaultEqualityFunction[int])
+}
+
+// a helper method to check the items of a set, ignoring order.
+//
+// calls t.Errorf if the set does not contain exactly the expected items.
+func checkCustomHashSetItems(t *testing.T, set *customhashset.CustomHashSet[int], expectedItems []int) {
+	t.Helper()
+
+	items := set.Items()
+	slices.Sort(items)
+	expectedItems = slices.Clone(expectedItems)
+	slices.Sort(expectedItems)
+	if !slices.Equal(items, expectedItems) {
+		t.Errorf("found items (%v) does not match expected items (%v)", items, expectedItems)
+	}
+	if set.Size() != len(expectedItems) {
+		t.Errorf("found size (%v) does not match expected size (%v)", set.Size(), len(expectedItems))
+	}
+}
+
+func TestCustomHashSetInit(t *testing.T) {
+	t.Run("int", func(t *testing.T) {
+		customhashset.New(hasher.DefaultIntegerHashFunction, hasher.DefaultEqualityFunction[int])
+	})
+	t.Run("string", func(t *testing.T) {
+		customhashset.New(hasher.DefaultStringHashFunction, hasher.DefaultEqualityFunction[string])
+	})
+	t.Run("slice", func(t *testing.T) {
+		customhashset.New(hasher.DefaultBytesHashFunction, slices.Equal[[]byte])
+	})
+	t.Run("struct", func(t *testing.T) {
+		type S struct {
+			name string
+			tags []string
+		}
+		customhashset.New(
+			func(item S) uint64 { return hasher.DefaultStringHashFunction(item.name) },
+			func(a, b S) bool { return a.name == b.name && slices.Equal(a.tags, b.tags) },
+		)
+	})
+}
+
+func TestCustomHashSetAddContainsRemove(t *testing.T) {
+	set := newIntegerSet(nil)
+	if !set.Add(1) {
+		t.Errorf("expected adding new item to return true")
+	}
+	if set.Add(1) {
+		t.Errorf("expected adding present item to return false")
+	}
+	set.Add(2)
+	if !set.Contains(1) || !set.Contains(2) || set.Contains(3) {
+		t.Errorf("found unexpected result from Contains")
+	}
+
+	if err := set.Remove(1); err != nil {
+		t.Errorf("error when removing present item: %v", err)
+	}
+	if err := set.Remove(1); !errors.Is(err, customhashset.ErrorItemNotContained) {
+		t.Errorf("expected ErrorItemNotContained when removing missing item, found (%v)", err)
+	}
+	checkCustomHashSetItems(t, set, []int{2})
+}
+
+func TestCustomHashSetCaseInsensitive(t *testing.T) {
+	set := customhashset.NewFromSlice(
+		[]string{"Go", "GO", "go", "Rust"},
+		func(item string) uint64 { return hasher.DefaultStringHashFunction(strings.ToLower(item)) },
+		strings.EqualFold,
+	)
+	if set.Size() != 2 {
+		t.Errorf("found size (%v) does not match expected size (%v)", set.Size(), 2)
+	}
+	if !set.Contains("gO") || !set.Contains("rust") {
+		t.Errorf("expected case insensitive set to contain items of any case")
+	}
+}
+
+func TestCustomHashSetNewFromSeq(t *testing.T) {
+	set := customhashset.NewFromSeq(slices.Values([]int{1, 2, 2, 3}), hasher.DefaultIntegerHashFunction, hasher.DefaultEqualityFunction[int])
+	checkCustomHashSetItems(t, set, []int{1, 2, 3})
+}
+
+func TestCustomHashSetApplyFold(t *testing.T) {
+	set := newIntegerSet([]int{1, 2, 3, 4, 5, 6, 7, 8, 9})
+
+	sum := 0
+	customhashset.Apply(set, func(item int) { sum += item })
+	if sum != 45 {
+		t.Errorf("result (%v) does not match expected result (%v)", sum, 45)
+	}
+
+	foldedSum := customhashset.Fold(set, 0, func(item int, accumulator int) int {
+		return accumulator + item
+	})
+	if foldedSum != 45 {
+		t.Errorf("result (%v) does not match expected result (%v)", foldedSum, 45)
+	}
+}
+
+// The set algebra of CustomHashSet must agree with that of HashSet.
+func TestCustomHashSetAlgebraMatchesHashSet(t *testing.T) {
+	randomGenerator := rand.New(rand.NewPCG(0, 0))
+	randomItems := func() []int {
+		items := make([]int, randomGenerator.IntN(30))
+		for index := range items {
+			items[index] = randomGenerator.IntN(40)
+		}
+		return items
+	}
+
+	for range 100 {
+		aItems := randomItems()
+		bItems := randomItems()
+		a, b := newIntegerSet(aItems), newIntegerSet(bItems)
+		expectedA, expectedB := hashset.NewFromSlice(aItems), hashset.NewFromSlice(bItems)
+
+		checkCustomHashSetItems(t, a.Union(b), expectedA.Union(expectedB).Items())
+		checkCustomHashSetItems(t, a.Intersection(b), expectedA.Intersection(expectedB).Items())
+		checkCustomHashSetItems(t, a.Difference(b), expectedA.Difference(expectedB).Items())
+		checkCustomHashSetItems(t, a.SymmetricDifference(b), expectedA.SymmetricDifference(expectedB).Items())
+
+		inPlace := newIntegerSet(aItems)
+		inPlace.UnionWith(b)
+		checkCustomHashSetItems(t, inPlace, expectedA.Union(expectedB).Items())
+		inPlace = newIntegerSet(aItems)
+		inPlace.IntersectWith(b)
+		checkCustomHashSetItems(t, inPlace, expectedA.Intersection(expectedB).Items())
+		inPlace = newIntegerSet(aItems)
+		inPlace.DifferenceWith(b)
+		checkCustomHashSetItems(t, inPlace, expectedA.Difference(expectedB).Items())
+		inPlace = newIntegerSet(aItems)
+		inPlace.SymmetricDifferenceWith(b)
+		checkCustomHashSetItems(t, inPlace, expectedA.SymmetricDifference(expectedB).Items())
+
+		if a.IsSubsetOf(b) != expectedA.IsSubsetOf(expectedB) {
+			t.Errorf("found IsSubsetOf (%v) does not match expected (%v)", a.IsSubsetOf(b), expectedA.IsSubsetOf(expectedB))
+		}
+		if a.IsSupersetOf(b) != expectedA.IsSupersetOf(expectedB) {
+			t.Errorf("found IsSupersetOf (%v) does not match expected (%v)", a.IsSupersetOf(b), expectedA.IsSupersetOf(expectedB))
+		}
+		if a.IsDisjoint(b) != expectedA.IsDisjoint(expectedB) {
+			t.Errorf("found IsDisjoint (%v) does not match expected (%v)", a.IsDisjoint(b), expectedA.IsDisjoint(expectedB))
+		}
+		if a.Equal(b) != expectedA.Equal(expectedB) {
+			t.Errorf("found Equal (%v) does not match expected (%v)", a.Equal(b), expectedA.Equal(expectedB))
+		}
+	}
+}
+
+func TestCustomHashSetAlgebraWithSelf(t *testing.T) {
+	set := newIntegerSet([]int{1, 2, 3})
+	set.UnionWith(set)
+	set.IntersectWith(set)
+	checkCustomHashSetItems(t, set, []int{1, 2, 3})
+	if !set.Equal(set) {
+		t.Errorf("expected set to equal itself")
+	}
+	set.SymmetricDifferenceWith(set)
+	checkCustomHashSetItems(t, set, []int{})
+
+	set = newIntegerSet([]int{1, 2, 3})
+	set.DifferenceWith(set)
+	checkCustomHashSetItems(t, set, []int{})
+}
@@ -14,6 +14,26 @@ func New[T comparable]() *HashSet[T] {
 	}
 }
 
+// Create a new HashSet containing the given items. Duplicate items are added only once.
+func NewFromSlice[T comparable](items []T) *HashSet[T] {
+	set := &HashSet[T]{
+		setData: make(map[T]interface{}, len(items)),
+	}
+	for _, item := range items {
+		set.setData[item] = struct{}{}
+	}
+	return set
+}
+
+// Create a new HashSet containing the items of a sequence. Duplicate items are added only once.
+func NewFromSeq[T comparable](seq iter.Seq[T]) *HashSet[T] {
+	set := New[T]()
+	for item := range seq {
+		set.setData[item] = struct{}{}
+	}
+	return set
+}
+
 // Return the size of the set, the number of items contained.
 func (set *HashSet[T]) Size() int {
 	return len(set.setData)
@@ -103,3 +123,156 @@ func (set *HashSet[T]) Iterator() iter.Seq[T] {
 		}
 	}
 }
+
+// ----------------------------------------------------------------------------
+// Set algebra methods
+//
+// Methods combining or comparing two sets. Methods such as Union return a new set and leave both sets unchanged,
+// while methods such as UnionWith update the receiving set in place.
+
+// Create a new set containing the items in either this set or other (or both).
+func (set *HashSet[T]) Union(other *HashSet[T]) *HashSet[T] {
+	result := &HashSet[T]{
+		setData: make(map[T]interface{}, max(set.Size(), other.Size())),
+	}
+	for item := range set.setData {
+		result.setData[item] = struct{}{}
+	}
+	for item := range other.setData {
+		result.setData[item] = struct{}{}
+	}
+	return result
+}
+
+// Add all items of other to this set, in place.
+func (set *HashSet[T]) UnionWith(other *HashSet[T]) {
+	for item := range other.setData {
+		set.setData[item] = struct{}{}
+	}
+}
+
+// Create a new set containing the items in both this set and other.
+//
+// The smaller of the two sets is iterated, so this is O(min(n, m)).
+func (set *HashSet[T]) Intersection(other *HashSet[T]) *HashSet[T] {
+	smaller, larger := set, other
+	if larger.Size() < smaller.Size() {
+		smaller, larger = larger, smaller
+	}
+
+	result := New[T]()
+	for item := range smaller.setData {
+		if larger.Contains(item) {
+			result.setData[item] = struct{}{}
+		}
+	}
+	return result
+}
+
+// Remove all items from this set that are not in other, in place.
+//
+// The smaller of the two sets is iterated, so this is O(min(n, m)).
+func (set *HashSet[T]) IntersectWith(other *HashSet[T]) {
+	if set.Size() <= other.Size() {
+		for item := range set.setData {
+			if !other.Contains(item) {
+				delete(set.setData, item)
+			}
+		}
+		return
+	}
+
+	// This set is larger, so it is cheaper to build the intersection from other
+	set.setData = set.Intersection(other).setData
+}
+
+// Create a new set containing the items in this set that are not in other.
+func (set *HashSet[T]) Difference(other *HashSet[T]) *HashSet[T] {
+	result := New[T]()
+	for item := range set.setData {
+		if !other.Contains(item) {
+			result.setData[item] = struct{}{}
+		}
+	}
+	return result
+}
+
+// Remove all items in other from this set, in place.
+func (set *HashSet[T]) DifferenceWith(other *HashSet[T]) {
+	if set == other {
+		clear(set.setData)
+		return
+	}
+
+	for item := range other.setData {
+		delete(set.setData, item)
+	}
+}
+
+// Create a new set containing the items in exactly one of this set and other.
+func (set *HashSet[T]) SymmetricDifference(other *HashSet[T]) *HashSet[T] {
+	result := set.Difference(other)
+	for item := range other.setData {
+		if !set.Contains(item) {
+			result.setData[item] = struct{}{}
+		}
+	}
+	return result
+}
+
+// Update this set to contain the items in exactly one of this set and other, in place.
+func (set *HashSet[T]) SymmetricDifferenceWith(other *HashSet[T]) {
+	if set == other {
+		clear(set.setData)
+		return
+	}
+
+	for item := range other.setData {
+		if set.Contains(item) {
+			delete(set.setData, item)
+		} else {
+			set.setData[item] = struct{}{}
+		}
+	}
+}
+
+// Determine if every item of this set is also in other.
+func (set *HashSet[T]) IsSubsetOf(other *HashSet[T]) bool {
+	if set.Size() > other.Size() {
+		return false
+	}
+
+	for item := range set.setData {
+		if !other.Contains(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// Determine if every item of other is also in this set.
+func (set *HashSet[T]) IsSupersetOf(other *HashSet[T]) bool {
+	return other.IsSubsetOf(set)
+}
+
+// Determine if this set and other have no items in common.
+//
+// The smaller of the two sets is iterated, so this is O(min(n, m)).
+func (set *HashSet[T]) IsDisjoint(other *HashSet[T]) bool {
+	smaller, larger := set, other
+	if larger.Size() < smaller.Size() {
+		smaller, larger = larger, smaller
+	}
+
+	for item := range smaller.setData {
+		if larger.Contains(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// Determine if this set and other contain exactly the same items.
+func (set *HashSet[T]) Equal(other *HashSet[T]) bool {
+	return set.Size() == other.Size() && set.IsSubsetOf(other)
+}
@@ -0,0 +1,193 @@
+package hashset_test
+
+import (
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	hashset "github.com/hmcalister/Go-DSA/set/HashSet"
+)
+
+// a helper method to check the items of a set, ignoring order.
+//
+// calls t.Errorf if the set does not contain exactly the expected items.
+func checkHashSetItems(t *testing.T, set *hashset.HashSet[int], expectedItems []int) {
+	t.Helper()
+
+	items := set.Items()
+	slices.Sort(items)
+	expectedItems = slices.Clone(expectedItems)
+	slices.Sort(expectedItems)
+	if !slices.Equal(items, expectedItems) {
+		t.Errorf("found items (%v) does not match expected items (%v)", items, expectedItems)
+	}
+}
+
+// Filter items into a new slice, keeping those satisfying the predicate.
+func filterItems(items []int, predicate func(item int) bool) []int {
+	result := make([]int, 0)
+	for _, item := range items {
+		if predicate(item) {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+func TestHashSetNewFromSlice(t *testing.T) {
+	set := hashset.NewFromSlice([]int{1, 2, 2, 3, 1})
+	checkHashSetItems(t, set, []int{1, 2, 3})
+
+	emptySet := hashset.NewFromSlice([]int{})
+	checkHashSetItems(t, emptySet, []int{})
+}
+
+func TestHashSetNewFromSeq(t *testing.T) {
+	set := hashset.NewFromSeq(slices.Values([]int{1, 2, 2, 3, 1}))
+	checkHashSetItems(t, set, []int{1, 2, 3})
+
+	keySet := hashset.NewFromSeq(maps.Keys(map[int]string{4: "a", 5: "b"}))
+	checkHashSetItems(t, keySet, []int{4, 5})
+
+	// A set can be constructed from the iterator of another set
+	copiedSet := hashset.NewFromSeq(set.Iterator())
+	if !copiedSet.Equal(set) {
+		t.Errorf("expected set constructed from iterator to equal original set")
+	}
+}
+
+func TestHashSetAlgebra(t *testing.T) {
+	a := hashset.NewFromSlice([]int{1, 2, 3, 4})
+	b := hashset.NewFromSlice([]int{3, 4, 5})
+
+	checkHashSetItems(t, a.Union(b), []int{1, 2, 3, 4, 5})
+	checkHashSetItems(t, a.Intersection(b), []int{3, 4})
+	checkHashSetItems(t, b.Intersection(a), []int{3, 4})
+	checkHashSetItems(t, a.Difference(b), []int{1, 2})
+	checkHashSetItems(t, b.Difference(a), []int{5})
+	checkHashSetItems(t, a.SymmetricDifference(b), []int{1, 2, 5})
+
+	// Methods returning new sets leave both sets unchanged
+	checkHashSetItems(t, a, []int{1, 2, 3, 4})
+	checkHashSetItems(t, b, []int{3, 4, 5})
+}
+
+func TestHashSetAlgebraInPlace(t *testing.T) {
+	newA := func() *hashset.HashSet[int] { return hashset.NewFromSlice([]int{1, 2, 3, 4}) }
+	b := hashset.NewFromSlice([]int{3, 4, 5})
+
+	set := newA()
+	set.UnionWith(b)
+	checkHashSetItems(t, set, []int{1, 2, 3, 4, 5})
+
+	set = newA()
+	set.IntersectWith(b)
+	checkHashSetItems(t, set, []int{3, 4})
+
+	set = newA()
+	set.DifferenceWith(b)
+	checkHashSetItems(t, set, []int{1, 2})
+
+	set = newA()
+	set.SymmetricDifferenceWith(b)
+	checkHashSetItems(t, set, []int{1, 2, 5})
+
+	// other is never modified
+	checkHashSetItems(t, b, []int{3, 4, 5})
+}
+
+func TestHashSetAlgebraWithSelf(t *testing.T) {
+	set := hashset.NewFromSlice([]int{1, 2, 3})
+	set.UnionWith(set)
+	checkHashSetItems(t, set, []int{1, 2, 3})
+	set.IntersectWith(set)
+	checkHashSetItems(t, set, []int{1, 2, 3})
+	set.SymmetricDifferenceWith(set)
+	checkHashSetItems(t, set, []int{})
+
+	set = hashset.NewFromSlice([]int{1, 2, 3})
+	set.DifferenceWith(set)
+	checkHashSetItems(t, set, []int{})
+}
+
+func TestHashSetAlgebraRandom(t *testing.T) {
+	randomGenerator := rand.New(rand.NewPCG(0, 0))
+	randomItems := func() []int {
+		items := make([]int, randomGenerator.IntN(30))
+		for index := range items {
+			items[index] = randomGenerator.IntN(40)
+		}
+		return items
+	}
+
+	for range 100 {
+		aItems := randomItems()
+		bItems := randomItems()
+		a := hashset.NewFromSlice(aItems)
+		b := hashset.NewFromSlice(bItems)
+		inA := func(item int) bool { return slices.Contains(aItems, item) }
+		inB := func(item int) bool { return slices.Contains(bItems, item) }
+
+		checkHashSetItems(t, a.Union(b), slices.Compact(slices.Sorted(slices.Values(slices.Concat(aItems, bItems)))))
+		checkHashSetItems(t, a.Intersection(b), slices.Compact(slices.Sorted(slices.Values(filterItems(aItems, inB)))))
+		checkHashSetItems(t, a.Difference(b), slices.Compact(slices.Sorted(slices.Values(filterItems(aItems, func(item int) bool { return !inB(item) })))))
+		checkHashSetItems(t, a.SymmetricDifference(b), slices.Compact(slices.Sorted(slices.Values(slices.Concat(
+			filterItems(aItems, func(item int) bool { return !inB(item) }),
+			filterItems(bItems, func(item int) bool { return !inA(item) }),
+		)))))
+
+		// The in place variants must agree with the new set variants, whichever set is larger
+		inPlace := hashset.NewFromSlice(aItems)
+		inPlace.IntersectWith(b)
+		if !inPlace.Equal(a.Intersection(b)) {
+			t.Errorf("IntersectWith result (%v) does not match Intersection result (%v)", inPlace.Items(), a.Intersection(b).Items())
+		}
+		inPlace = hashset.NewFromSlice(aItems)
+		inPlace.SymmetricDifferenceWith(b)
+		if !inPlace.Equal(a.SymmetricDifference(b)) {
+			t.Errorf("SymmetricDifferenceWith result (%v) does not match SymmetricDifference result (%v)", inPlace.Items(), a.SymmetricDifference(b).Items())
+		}
+
+		expectedSubset := len(filterItems(aItems, inB)) == len(aItems)
+		if a.IsSubsetOf(b) != expectedSubset {
+			t.Errorf("found IsSubsetOf (%v) does not match expected (%v) for sets (%v) and (%v)", a.IsSubsetOf(b), expectedSubset, aItems, bItems)
+		}
+		expectedDisjoint := len(filterItems(aItems, inB)) == 0
+		if a.IsDisjoint(b) != expectedDisjoint {
+			t.Errorf("found IsDisjoint (%v) does not match expected (%v) for sets (%v) and (%v)", a.IsDisjoint(b), expectedDisjoint, aItems, bItems)
+		}
+	}
+}
+
+func TestHashSetComparisons(t *testing.T) {
+	empty := hashset.New[int]()
+	small := hashset.NewFromSlice([]int{1, 2})
+	large := hashset.NewFromSlice([]int{1, 2, 3})
+	other := hashset.NewFromSlice([]int{4, 5})
+
+	testCases := []struct {
+		name     string
+		result   bool
+		expected bool
+	}{
+		{"small subset of large", small.IsSubsetOf(large), true},
+		{"large subset of small", large.IsSubsetOf(small), false},
+		{"small subset of itself", small.IsSubsetOf(small), true},
+		{"empty subset of small", empty.IsSubsetOf(small), true},
+		{"large superset of small", large.IsSupersetOf(small), true},
+		{"small superset of large", small.IsSupersetOf(large), false},
+		{"small disjoint from other", small.IsDisjoint(other), true},
+		{"small disjoint from large", small.IsDisjoint(large), false},
+		{"empty disjoint from empty", empty.IsDisjoint(empty), true},
+		{"small equal to copy", small.Equal(hashset.NewFromSlice([]int{2, 1})), true},
+		{"small equal to large", small.Equal(large), false},
+		{"small equal to other", small.Equal(other), false},
+		{"empty equal to empty", empty.Equal(hashset.New[int]()), true},
+	}
+	for _, testCase := range testCases {
+		if testCase.result != testCase.expected {
+			t.Errorf("%v: found (%v) does not match expected (%v)", testCase.name, testCase.result, testCase.expected)
+		}
+	}
+}
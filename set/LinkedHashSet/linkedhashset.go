@@ -0,0 +1,175 @@
+package linkedhashset
+
+import (
+	"iter"
+
+	linkedhashmap "github.com/hmcalister/Go-DSA/map/LinkedHashMap"
+)
+
+// Determines the iteration order of a LinkedHashSet. See github.com/hmcalister/Go-DSA/map/LinkedHashMap.
+type Order = linkedhashmap.Order
+
+const (
+	// Items are ordered by when they were first added. Adding an item already present does not move it.
+	InsertionOrder = linkedhashmap.InsertionOrder
+
+	// Items are ordered by when they were last added, least recently added first.
+	// Adding an item already present moves it to the end.
+	AccessOrder = linkedhashmap.AccessOrder
+)
+
+// An implementation of a set with predictable iteration order.
+//
+// Unlike github.com/hmcalister/Go-DSA/set/HashSet, whose iteration order is the randomised order of a Go map,
+// items are iterated in the order they were added (or last added, in AccessOrder).
+// Add, Contains, Remove and MoveToEnd are all O(1).
+type LinkedHashSet[T comparable] struct {
+	setData *linkedhashmap.LinkedHashMap[T, struct{}]
+}
+
+// Create a new LinkedHashSet with the given iteration order.
+func New[T comparable](order Order) *LinkedHashSet[T] {
+	return &LinkedHashSet[T]{
+		setData: linkedhashmap.New[T, struct{}](order),
+	}
+}
+
+// Create a new LinkedHashSet containing the given items, in the given iteration order.
+// Duplicate items are added only once.
+func NewFromSlice[T comparable](items []T, order Order) *LinkedHashSet[T] {
+	set := New[T](order)
+	for _, item := range items {
+		set.Add(item)
+	}
+	return set
+}
+
+// Create a new LinkedHashSet containing the items of a sequence, in the given iteration order.
+// Duplicate items are added only once.
+func NewFromSeq[T comparable](seq iter.Seq[T], order Order) *LinkedHashSet[T] {
+	set := New[T](order)
+	for item := range seq {
+		set.Add(item)
+	}
+	return set
+}
+
+// Return the size of the set, the number of items contained.
+func (set *LinkedHashSet[T]) Size() int {
+	return set.setData.Size()
+}
+
+// Get the iteration order of the set.
+func (set *LinkedHashSet[T]) Order() Order {
+	return set.setData.Order()
+}
+
+// Add an item to the end of the set. Returns true if the item was *not* already present.
+//
+// An item already present keeps its position in InsertionOrder, or is moved to the end in AccessOrder.
+func (set *LinkedHashSet[T]) Add(item T) bool {
+	_, replaced := set.setData.Put(item, struct{}{})
+	return !replaced
+}
+
+// Checks if an item is already present in the set. This does not affect the order of the set.
+func (set *LinkedHashSet[T]) Contains(item T) bool {
+	return set.setData.ContainsKey(item)
+}
+
+// Move an item to the end of the set, as if it were the most recently added item.
+// Returns an error if the item is not contained in the set.
+func (set *LinkedHashSet[T]) MoveToEnd(item T) error {
+	if err := set.setData.MoveToEnd(item); err != nil {
+		return ErrorItemNotContained
+	}
+	return nil
+}
+
+// Remove an item from the set. Returns an error if the item is not contained in the set.
+func (set *LinkedHashSet[T]) Remove(item T) error {
+	if _, err := set.setData.Remove(item); err != nil {
+		return ErrorItemNotContained
+	}
+	return nil
+}
+
+// Get the first item of the set, the least recently added.
+//
+// Returns a dsa_error.ErrorDataStructureEmpty if the set is empty.
+func (set *LinkedHashSet[T]) Oldest() (T, error) {
+	item, _, err := set.setData.Oldest()
+	return item, err
+}
+
+// Get the last item of the set, the most recently added.
+//
+// Returns a dsa_error.ErrorDataStructureEmpty if the set is empty.
+func (set *LinkedHashSet[T]) Newest() (T, error) {
+	item, _, err := set.setData.Newest()
+	return item, err
+}
+
+// Remove and return the first item of the set, the least recently added.
+//
+// Returns a dsa_error.ErrorDataStructureEmpty if the set is empty.
+func (set *LinkedHashSet[T]) RemoveOldest() (T, error) {
+	item, _, err := set.setData.RemoveOldest()
+	return item, err
+}
+
+// Get all items from the set, in order. This method allocates an array of length equal to the number of items.
+func (set *LinkedHashSet[T]) Items() []T {
+	return set.setData.Keys()
+}
+
+// Iterate over the items of the set in order and apply a function to each item.
+//
+// Idiomatic Go should likely use Iterator() rather than functional methods.
+//
+// To accumulate values over items, use Fold.
+func Apply[T comparable](set *LinkedHashSet[T], f func(item T)) {
+	for item := range set.Iterator() {
+		f(item)
+	}
+}
+
+// Iterate over set items in order and apply the function f.
+// The function f also takes the current value of the accumulator.
+// The results of f become the new value of the accumulator at each step.
+//
+// Idiomatic Go should likely use Iterator() rather than functional methods.
+//
+// This function is not a method on LinkedHashSet to allow for generic accumulators.
+func Fold[T comparable, G any](set *LinkedHashSet[T], initialAccumulator G, f func(item T, accumulator G) G) G {
+	accumulator := initialAccumulator
+	for item := range set.Iterator() {
+		accumulator = f(item, accumulator)
+	}
+
+	return accumulator
+}
+
+// Iterate over the items of the set in order, oldest first.
+// This method is not concurrency safe. For concurrent applications, consider using a mutex, or pull the data out using Items().
+func (set *LinkedHashSet[T]) Iterator() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for item := range set.setData.Iterator() {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// Iterate over the items of the set in reverse order, newest first.
+// This method is not concurrency safe. For concurrent applications, consider using a mutex, or pull the data out using Items().
+func (set *LinkedHashSet[T]) ReverseIterator() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for item := range set.setData.ReverseIterator() {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
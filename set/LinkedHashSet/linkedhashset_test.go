@@ -0,0 +1,143 @@
+package linkedhashset_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	linkedhashset "github.com/hmcalister/Go-DSA/set/LinkedHashSet"
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
+)
+
+// a helper method to check the items of a set are exactly the expected items, in order.
+//
+// calls t.Errorf if the items do not match.
+func checkLinkedHashSetItems(t *testing.T, set *linkedhashset.LinkedHashSet[int], expectedItems []int) {
+	t.Helper()
+
+	items := set.Items()
+	if !slices.Equal(items, expectedItems) {
+		t.Errorf("found items (%v) does not match expected items (%v)", items, expectedItems)
+	}
+	if set.Size() != len(expectedItems) {
+		t.Errorf("found size (%v) does not match expected size (%v)", set.Size(), len(expectedItems))
+	}
+}
+
+func TestLinkedHashSetInit(t *testing.T) {
+	t.Run("int", func(t *testing.T) {
+		linkedhashset.New[int](linkedhashset.InsertionOrder)
+	})
+	t.Run("float", func(t *testing.T) {
+		linkedhashset.New[float64](linkedhashset.InsertionOrder)
+	})
+	t.Run("string", func(t *testing.T) {
+		linkedhashset.New[string](linkedhashset.AccessOrder)
+	})
+	t.Run("struct", func(t *testing.T) {
+		type S struct {
+			_ int
+			_ float64
+			_ string
+		}
+		linkedhashset.New[S](linkedhashset.AccessOrder)
+	})
+}
+
+func TestLinkedHashSetAddContainsRemove(t *testing.T) {
+	set := linkedhashset.New[int](linkedhashset.InsertionOrder)
+	for _, item := range []int{5, 3, 9, 1} {
+		if !set.Add(item) {
+			t.Errorf("expected adding new item (%v) to return true", item)
+		}
+	}
+	if set.Add(3) {
+		t.Errorf("expected adding present item to return false")
+	}
+	checkLinkedHashSetItems(t, set, []int{5, 3, 9, 1})
+
+	if !set.Contains(9) || set.Contains(2) {
+		t.Errorf("found unexpected result from Contains")
+	}
+
+	if err := set.Remove(3); err != nil {
+		t.Errorf("error when removing present item: %v", err)
+	}
+	if err := set.Remove(3); !errors.Is(err, linkedhashset.ErrorItemNotContained) {
+		t.Errorf("expected ErrorItemNotContained when removing missing item, found (%v)", err)
+	}
+	checkLinkedHashSetItems(t, set, []int{5, 9, 1})
+}
+
+func TestLinkedHashSetAccessOrder(t *testing.T) {
+	set := linkedhashset.NewFromSlice([]int{1, 2, 3, 4}, linkedhashset.AccessOrder)
+	set.Add(2)
+	checkLinkedHashSetItems(t, set, []int{1, 3, 4, 2})
+
+	// Contains does not count as an access
+	set.Contains(1)
+	checkLinkedHashSetItems(t, set, []int{1, 3, 4, 2})
+}
+
+func TestLinkedHashSetMoveToEnd(t *testing.T) {
+	set := linkedhashset.NewFromSlice([]int{1, 2, 3}, linkedhashset.InsertionOrder)
+	if err := set.MoveToEnd(1); err != nil {
+		t.Errorf("error when moving present item: %v", err)
+	}
+	checkLinkedHashSetItems(t, set, []int{2, 3, 1})
+	if err := set.MoveToEnd(4); !errors.Is(err, linkedhashset.ErrorItemNotContained) {
+		t.Errorf("expected ErrorItemNotContained when moving missing item, found (%v)", err)
+	}
+}
+
+func TestLinkedHashSetOldestNewest(t *testing.T) {
+	set := linkedhashset.New[int](linkedhashset.InsertionOrder)
+	if _, err := set.RemoveOldest(); !errors.Is(err, dsa_error.ErrorDataStructureEmpty) {
+		t.Errorf("expected ErrorDataStructureEmpty from empty set, found (%v)", err)
+	}
+
+	set.Add(7)
+	set.Add(8)
+	set.Add(9)
+	if item, err := set.Oldest(); err != nil || item != 7 {
+		t.Errorf("found oldest item (%v, %v) does not match expected item (%v, %v)", item, err, 7, nil)
+	}
+	if item, err := set.Newest(); err != nil || item != 9 {
+		t.Errorf("found newest item (%v, %v) does not match expected item (%v, %v)", item, err, 9, nil)
+	}
+	if item, err := set.RemoveOldest(); err != nil || item != 7 {
+		t.Errorf("found removed item (%v, %v) does not match expected item (%v, %v)", item, err, 7, nil)
+	}
+	checkLinkedHashSetItems(t, set, []int{8, 9})
+}
+
+func TestLinkedHashSetNewFromSeq(t *testing.T) {
+	set := linkedhashset.NewFromSeq(slices.Values([]int{3, 1, 3, 2, 1}), linkedhashset.InsertionOrder)
+	checkLinkedHashSetItems(t, set, []int{3, 1, 2})
+
+	// Iteration is deterministic, so a copy through the iterator preserves order
+	copiedSet := linkedhashset.NewFromSeq(set.Iterator(), linkedhashset.InsertionOrder)
+	checkLinkedHashSetItems(t, copiedSet, []int{3, 1, 2})
+
+	reversedItems := slices.Collect(set.ReverseIterator())
+	if !slices.Equal(reversedItems, []int{2, 1, 3}) {
+		t.Errorf("found reversed items (%v) does not match expected items (%v)", reversedItems, []int{2, 1, 3})
+	}
+}
+
+func TestLinkedHashSetApplyFold(t *testing.T) {
+	set := linkedhashset.NewFromSlice([]int{4, 2, 7}, linkedhashset.InsertionOrder)
+
+	visitedItems := make([]int, 0)
+	linkedhashset.Apply(set, func(item int) { visitedItems = append(visitedItems, item) })
+	if !slices.Equal(visitedItems, []int{4, 2, 7}) {
+		t.Errorf("found visited items (%v) are not in insertion order", visitedItems)
+	}
+
+	digits := linkedhashset.Fold(set, 0, func(item int, accumulator int) int {
+		return 10*accumulator + item
+	})
+	if digits != 427 {
+		t.Errorf("result (%v) does not match expected result (%v)", digits, 427)
+	}
+}
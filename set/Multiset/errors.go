@@ -0,0 +1,7 @@
+package multiset
+
+import "errors"
+
+var (
+	ErrorNegativeCount = errors.New("count must not be negative")
+)
@@ -0,0 +1,147 @@
+package multiset
+
+import (
+	"iter"
+
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
+)
+
+// An implementation of a multiset (or bag) using a map from items to counts.
+//
+// Unlike github.com/hmcalister/Go-DSA/set/HashSet, adding an item multiple times is recorded,
+// so the number of occurrences of each item can be found without a separate map of counts.
+type HashMultiset[T comparable] struct {
+	// The count of each item. Items with a count of zero are removed from the map.
+	counts map[T]int
+
+	// The total number of occurrences of all items.
+	totalSize int
+}
+
+// Create a new HashMultiset.
+func NewHashMultiset[T comparable]() *HashMultiset[T] {
+	return &HashMultiset[T]{
+		counts:    make(map[T]int),
+		totalSize: 0,
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Get Methods
+
+// Get the number of distinct items in the multiset.
+func (multiset *HashMultiset[T]) DistinctSize() int {
+	return len(multiset.counts)
+}
+
+// Get the total number of occurrences of all items in the multiset.
+func (multiset *HashMultiset[T]) TotalSize() int {
+	return multiset.totalSize
+}
+
+// Get the number of occurrences of an item in the multiset. Returns zero if the item is not present.
+func (multiset *HashMultiset[T]) Count(item T) int {
+	return multiset.counts[item]
+}
+
+// Checks if at least one occurrence of an item is present in the multiset.
+func (multiset *HashMultiset[T]) Contains(item T) bool {
+	_, ok := multiset.counts[item]
+	return ok
+}
+
+// Get the k most common items of the multiset with their counts, most common first.
+// If the multiset has fewer than k distinct items, all items are returned.
+//
+// BEWARE: Items with equal counts are ordered arbitrarily, and when items with equal counts
+// straddle the k-th place, which of those items are returned is also arbitrary.
+func (multiset *HashMultiset[T]) MostCommon(k int) []ItemCount[T] {
+	return mostCommon(multiset.Iterator(), k, nil)
+}
+
+// Get all distinct items from the multiset. This method allocates an array of length equal to the number of distinct items.
+// The items are not guaranteed to be in the order they were inserted into the multiset.
+func (multiset *HashMultiset[T]) Items() []T {
+	items := make([]T, 0, len(multiset.counts))
+	for item := range multiset.counts {
+		items = append(items, item)
+	}
+	return items
+}
+
+// Iterate over the distinct items of the multiset and their counts. Note the iteration order may not be the insertion order.
+// This method is not concurrency safe. For concurrent applications, consider using a mutex, or pull the data out using Items().
+func (multiset *HashMultiset[T]) Iterator() iter.Seq2[T, int] {
+	return func(yield func(T, int) bool) {
+		for item, count := range multiset.counts {
+			if !yield(item, count) {
+				return
+			}
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Add Methods
+
+// Add n occurrences of an item to the multiset, returning the new count of the item.
+// Adding zero occurrences does not change the multiset.
+//
+// Returns an ErrorNegativeCount if n is negative.
+func (multiset *HashMultiset[T]) Add(item T, n int) (int, error) {
+	if n < 0 {
+		return multiset.counts[item], ErrorNegativeCount
+	}
+	if n == 0 {
+		return multiset.counts[item], nil
+	}
+
+	multiset.counts[item] += n
+	multiset.totalSize += n
+	return multiset.counts[item], nil
+}
+
+// Set the number of occurrences of an item in the multiset, returning the previous count of the item.
+// Setting the count to zero removes the item.
+//
+// Returns an ErrorNegativeCount if count is negative.
+func (multiset *HashMultiset[T]) SetCount(item T, count int) (int, error) {
+	previousCount := multiset.counts[item]
+	if count < 0 {
+		return previousCount, ErrorNegativeCount
+	}
+
+	if count == 0 {
+		delete(multiset.counts, item)
+	} else {
+		multiset.counts[item] = count
+	}
+	multiset.totalSize += count - previousCount
+	return previousCount, nil
+}
+
+// ----------------------------------------------------------------------------
+// Remove Methods
+
+// Remove up to n occurrences of an item from the multiset, returning the number of occurrences removed.
+// If the item has fewer than n occurrences, all occurrences are removed.
+//
+// Returns a dsa_error.ErrorItemNotFound if the item is not present, or an ErrorNegativeCount if n is negative.
+func (multiset *HashMultiset[T]) Remove(item T, n int) (int, error) {
+	if n < 0 {
+		return 0, ErrorNegativeCount
+	}
+	count, ok := multiset.counts[item]
+	if !ok {
+		return 0, dsa_error.ErrorItemNotFound
+	}
+
+	removed := min(n, count)
+	if removed == count {
+		delete(multiset.counts, item)
+	} else {
+		multiset.counts[item] = count - removed
+	}
+	multiset.totalSize -= removed
+	return removed, nil
+}
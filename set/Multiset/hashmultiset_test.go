@@ -0,0 +1,164 @@
+package multiset_test
+
+import (
+	"errors"
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	multiset "github.com/hmcalister/Go-DSA/set/Multiset"
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
+)
+
+// a helper method to check the counts of a multiset against a Go map of expected counts.
+//
+// calls t.Errorf if the counts or sizes do not match.
+func checkMultisetCounts(t *testing.T, itemCounts map[int]int, distinctSize int, totalSize int, count func(item int) int, expectedCounts map[int]int) {
+	t.Helper()
+
+	if !maps.Equal(itemCounts, expectedCounts) {
+		t.Errorf("found counts (%v) does not match expected counts (%v)", itemCounts, expectedCounts)
+	}
+	for item, expectedCount := range expectedCounts {
+		if count(item) != expectedCount {
+			t.Errorf("found count (%v) of item (%v) does not match expected count (%v)", count(item), item, expectedCount)
+		}
+	}
+	expectedTotalSize := 0
+	for _, expectedCount := range expectedCounts {
+		expectedTotalSize += expectedCount
+	}
+	if distinctSize != len(expectedCounts) {
+		t.Errorf("found distinct size (%v) does not match expected distinct size (%v)", distinctSize, len(expectedCounts))
+	}
+	if totalSize != expectedTotalSize {
+		t.Errorf("found total size (%v) does not match expected total size (%v)", totalSize, expectedTotalSize)
+	}
+}
+
+func checkHashMultisetCounts(t *testing.T, bag *multiset.HashMultiset[int], expectedCounts map[int]int) {
+	t.Helper()
+	checkMultisetCounts(t, maps.Collect(bag.Iterator()), bag.DistinctSize(), bag.TotalSize(), bag.Count, expectedCounts)
+}
+
+func TestHashMultisetInit(t *testing.T) {
+	t.Run("int", func(t *testing.T) {
+		multiset.NewHashMultiset[int]()
+	})
+	t.Run("float", func(t *testing.T) {
+		multiset.NewHashMultiset[float64]()
+	})
+	t.Run("string", func(t *testing.T) {
+		multiset.NewHashMultiset[string]()
+	})
+	t.Run("struct", func(t *testing.T) {
+		type S struct {
+			_ int
+			_ float64
+			_ string
+		}
+		multiset.NewHashMultiset[S]()
+	})
+}
+
+func TestHashMultisetAddRemove(t *testing.T) {
+	bag := multiset.NewHashMultiset[int]()
+
+	if count, err := bag.Add(1, 3); err != nil || count != 3 {
+		t.Errorf("found count (%v, %v) does not match expected count (%v, %v)", count, err, 3, nil)
+	}
+	if count, err := bag.Add(1, 2); err != nil || count != 5 {
+		t.Errorf("found count (%v, %v) does not match expected count (%v, %v)", count, err, 5, nil)
+	}
+	bag.Add(2, 1)
+	bag.Add(3, 0)
+	if _, err := bag.Add(3, -1); !errors.Is(err, multiset.ErrorNegativeCount) {
+		t.Errorf("expected ErrorNegativeCount when adding negative count, found (%v)", err)
+	}
+	checkHashMultisetCounts(t, bag, map[int]int{1: 5, 2: 1})
+	if bag.Contains(3) {
+		t.Errorf("expected adding zero occurrences to not add item")
+	}
+
+	if removed, err := bag.Remove(1, 2); err != nil || removed != 2 {
+		t.Errorf("found removed (%v, %v) does not match expected removed (%v, %v)", removed, err, 2, nil)
+	}
+	if removed, err := bag.Remove(2, 10); err != nil || removed != 1 {
+		t.Errorf("found removed (%v, %v) does not match expected removed (%v, %v)", removed, err, 1, nil)
+	}
+	if _, err := bag.Remove(2, 1); !errors.Is(err, dsa_error.ErrorItemNotFound) {
+		t.Errorf("expected ErrorItemNotFound when removing missing item, found (%v)", err)
+	}
+	if _, err := bag.Remove(1, -1); !errors.Is(err, multiset.ErrorNegativeCount) {
+		t.Errorf("expected ErrorNegativeCount when removing negative count, found (%v)", err)
+	}
+	checkHashMultisetCounts(t, bag, map[int]int{1: 3})
+}
+
+func TestHashMultisetSetCount(t *testing.T) {
+	bag := multiset.NewHashMultiset[int]()
+	bag.Add(1, 2)
+
+	if previousCount, err := bag.SetCount(1, 7); err != nil || previousCount != 2 {
+		t.Errorf("found previous count (%v, %v) does not match expected (%v, %v)", previousCount, err, 2, nil)
+	}
+	bag.SetCount(2, 4)
+	checkHashMultisetCounts(t, bag, map[int]int{1: 7, 2: 4})
+
+	bag.SetCount(1, 0)
+	if _, err := bag.SetCount(2, -1); !errors.Is(err, multiset.ErrorNegativeCount) {
+		t.Errorf("expected ErrorNegativeCount when setting negative count, found (%v)", err)
+	}
+	checkHashMultisetCounts(t, bag, map[int]int{2: 4})
+}
+
+func TestHashMultisetMostCommon(t *testing.T) {
+	bag := multiset.NewHashMultiset[string]()
+	for _, word := range []string{"a", "b", "a", "c", "a", "b", "d"} {
+		bag.Add(word, 1)
+	}
+
+	mostCommon := bag.MostCommon(2)
+	expected := []multiset.ItemCount[string]{{Item: "a", Count: 3}, {Item: "b", Count: 2}}
+	if !slices.Equal(mostCommon, expected) {
+		t.Errorf("found most common (%v) does not match expected (%v)", mostCommon, expected)
+	}
+	if len(bag.MostCommon(10)) != 4 {
+		t.Errorf("found length of most common (%v) does not match expected length (%v)", len(bag.MostCommon(10)), 4)
+	}
+	if len(bag.MostCommon(0)) != 0 {
+		t.Errorf("expected most common of zero items to be empty")
+	}
+}
+
+func TestHashMultisetRandomOperations(t *testing.T) {
+	randomGenerator := rand.New(rand.NewPCG(0, 0))
+	bag := multiset.NewHashMultiset[int]()
+	expectedCounts := make(map[int]int)
+
+	for range 5000 {
+		item := randomGenerator.IntN(30)
+		n := randomGenerator.IntN(5)
+		if randomGenerator.IntN(2) == 0 {
+			bag.Add(item, n)
+			if n > 0 {
+				expectedCounts[item] += n
+			}
+		} else {
+			bag.Remove(item, n)
+			expectedCounts[item] -= min(n, expectedCounts[item])
+			if expectedCounts[item] == 0 {
+				delete(expectedCounts, item)
+			}
+		}
+	}
+	checkHashMultisetCounts(t, bag, expectedCounts)
+
+	items := bag.Items()
+	slices.Sort(items)
+	expectedItems := slices.Sorted(maps.Keys(expectedCounts))
+	if !slices.Equal(items, expectedItems) {
+		t.Errorf("found items (%v) does not match expected items (%v)", items, expectedItems)
+	}
+}
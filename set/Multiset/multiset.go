@@ -0,0 +1,42 @@
+package multiset
+
+import (
+	"iter"
+
+	boundedpriorityqueue "github.com/hmcalister/Go-DSA/queue/BoundedPriorityQueue"
+	comparator "github.com/hmcalister/Go-DSA/utils/Comparator"
+)
+
+// An item of a multiset, along with the number of times the item occurs.
+type ItemCount[T any] struct {
+	Item  T
+	Count int
+}
+
+// Find the k items with the largest counts, most common first.
+//
+// Items with equal counts are ordered by the tieBreak comparator, smallest first. If tieBreak is nil, ties are broken arbitrarily.
+func mostCommon[T any](itemCounts iter.Seq2[T, int], k int, tieBreak comparator.ComparatorFunction[T]) []ItemCount[T] {
+	if k <= 0 {
+		return []ItemCount[T]{}
+	}
+
+	// The bounded priority queue keeps the k best items, where better items have a larger count.
+	// Since k is positive, creating the queue cannot fail.
+	topItems, _ := boundedpriorityqueue.New(k, boundedpriorityqueue.EvictWorst, func(a, b ItemCount[T]) int {
+		if a.Count != b.Count {
+			return b.Count - a.Count
+		}
+		if tieBreak != nil {
+			return tieBreak(a.Item, b.Item)
+		}
+		return 0
+	})
+	for item, count := range itemCounts {
+		topItems.Add(ItemCount[T]{
+			Item:  item,
+			Count: count,
+		})
+	}
+	return topItems.SortedItems()
+}
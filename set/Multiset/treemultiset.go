@@ -0,0 +1,185 @@
+package multiset
+
+import (
+	"iter"
+
+	redblacktree "github.com/hmcalister/Go-DSA/tree/RedBlackTree"
+	comparator "github.com/hmcalister/Go-DSA/utils/Comparator"
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
+)
+
+// An item of a TreeMultiset along with its count, stored in the nodes of the tree.
+//
+// The tree holds pointers so that counts may be updated in place; the count does not affect the ordering.
+type countedItem[T any] struct {
+	item  T
+	count int
+}
+
+// An implementation of an ordered multiset (or bag) using a red-black tree of items and counts.
+//
+// Unlike github.com/hmcalister/Go-DSA/tree/RedBlackTree, adding an item already present increments its count
+// rather than returning an error. Items are ordered by a ComparatorFunction, and iteration is in sorted order.
+type TreeMultiset[T any] struct {
+	tree *redblacktree.RedBlackTree[*countedItem[T]]
+
+	// Comparator function to compare and order the type T
+	comparatorFunction comparator.ComparatorFunction[T]
+
+	// The total number of occurrences of all items.
+	totalSize int
+}
+
+// Create a new TreeMultiset ordered by the given comparator function.
+//
+// The comparator function (see github.com/hmcalister/Go-DSA/Comparator) defines how the items are ordered.
+// Items that compare equal are counted as occurrences of the same item.
+func NewTreeMultiset[T any](comparatorFunction comparator.ComparatorFunction[T]) *TreeMultiset[T] {
+	return &TreeMultiset[T]{
+		tree: redblacktree.New(func(a, b *countedItem[T]) int {
+			return comparatorFunction(a.item, b.item)
+		}),
+		comparatorFunction: comparatorFunction,
+		totalSize:          0,
+	}
+}
+
+// Find the counted item of an item, or nil if the item is not present.
+func (multiset *TreeMultiset[T]) find(item T) *countedItem[T] {
+	node, err := multiset.tree.Find(&countedItem[T]{item: item})
+	if err != nil {
+		return nil
+	}
+	return node.Item()
+}
+
+// ----------------------------------------------------------------------------
+// Get Methods
+
+// Get the number of distinct items in the multiset.
+func (multiset *TreeMultiset[T]) DistinctSize() int {
+	if multiset.tree.Root() == nil {
+		return 0
+	}
+	return multiset.tree.Root().Size()
+}
+
+// Get the total number of occurrences of all items in the multiset.
+func (multiset *TreeMultiset[T]) TotalSize() int {
+	return multiset.totalSize
+}
+
+// Get the number of occurrences of an item in the multiset. Returns zero if the item is not present.
+func (multiset *TreeMultiset[T]) Count(item T) int {
+	counted := multiset.find(item)
+	if counted == nil {
+		return 0
+	}
+	return counted.count
+}
+
+// Checks if at least one occurrence of an item is present in the multiset.
+func (multiset *TreeMultiset[T]) Contains(item T) bool {
+	return multiset.find(item) != nil
+}
+
+// Get the k most common items of the multiset with their counts, most common first.
+// If the multiset has fewer than k distinct items, all items are returned.
+//
+// Items with equal counts are ordered by the comparator function, smallest first.
+func (multiset *TreeMultiset[T]) MostCommon(k int) []ItemCount[T] {
+	return mostCommon(multiset.Iterator(), k, multiset.comparatorFunction)
+}
+
+// Get all distinct items from the multiset in sorted order. This method allocates an array of length equal to the number of distinct items.
+func (multiset *TreeMultiset[T]) Items() []T {
+	items := make([]T, 0, multiset.DistinctSize())
+	for item := range multiset.Iterator() {
+		items = append(items, item)
+	}
+	return items
+}
+
+// Iterate over the distinct items of the multiset and their counts, in sorted order.
+// This method is not concurrency safe. For concurrent applications, consider using a mutex, or pull the data out using Items().
+func (multiset *TreeMultiset[T]) Iterator() iter.Seq2[T, int] {
+	return func(yield func(T, int) bool) {
+		for counted := range redblacktree.IteratorTreeInorder(multiset.tree) {
+			if !yield(counted.item, counted.count) {
+				return
+			}
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Add Methods
+
+// Add n occurrences of an item to the multiset, returning the new count of the item.
+// Adding zero occurrences does not change the multiset.
+//
+// Returns an ErrorNegativeCount if n is negative.
+func (multiset *TreeMultiset[T]) Add(item T, n int) (int, error) {
+	if n < 0 {
+		return multiset.Count(item), ErrorNegativeCount
+	}
+	if n == 0 {
+		return multiset.Count(item), nil
+	}
+
+	counted := multiset.find(item)
+	multiset.totalSize += n
+	if counted != nil {
+		counted.count += n
+		return counted.count, nil
+	}
+
+	multiset.tree.Add(&countedItem[T]{
+		item:  item,
+		count: n,
+	})
+	return n, nil
+}
+
+// Set the number of occurrences of an item in the multiset, returning the previous count of the item.
+// Setting the count to zero removes the item.
+//
+// Returns an ErrorNegativeCount if count is negative.
+func (multiset *TreeMultiset[T]) SetCount(item T, count int) (int, error) {
+	previousCount := multiset.Count(item)
+	if count < 0 {
+		return previousCount, ErrorNegativeCount
+	}
+
+	if previousCount < count {
+		multiset.Add(item, count-previousCount)
+	} else if previousCount > count {
+		multiset.Remove(item, previousCount-count)
+	}
+	return previousCount, nil
+}
+
+// ----------------------------------------------------------------------------
+// Remove Methods
+
+// Remove up to n occurrences of an item from the multiset, returning the number of occurrences removed.
+// If the item has fewer than n occurrences, all occurrences are removed.
+//
+// Returns a dsa_error.ErrorItemNotFound if the item is not present, or an ErrorNegativeCount if n is negative.
+func (multiset *TreeMultiset[T]) Remove(item T, n int) (int, error) {
+	if n < 0 {
+		return 0, ErrorNegativeCount
+	}
+	counted := multiset.find(item)
+	if counted == nil {
+		return 0, dsa_error.ErrorItemNotFound
+	}
+
+	removed := min(n, counted.count)
+	counted.count -= removed
+	if counted.count == 0 {
+		multiset.tree.Remove(counted)
+	}
+	multiset.totalSize -= removed
+	return removed, nil
+}
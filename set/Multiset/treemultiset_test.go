@@ -0,0 +1,126 @@
+package multiset_test
+
+import (
+	"errors"
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	multiset "github.com/hmcalister/Go-DSA/set/Multiset"
+	comparator "github.com/hmcalister/Go-DSA/utils/Comparator"
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
+)
+
+func checkTreeMultisetCounts(t *testing.T, bag *multiset.TreeMultiset[int], expectedCounts map[int]int) {
+	t.Helper()
+	checkMultisetCounts(t, maps.Collect(bag.Iterator()), bag.DistinctSize(), bag.TotalSize(), bag.Count, expectedCounts)
+
+	items := bag.Items()
+	expectedItems := slices.Sorted(maps.Keys(expectedCounts))
+	if !slices.Equal(items, expectedItems) {
+		t.Errorf("found items (%v) does not match expected sorted items (%v)", items, expectedItems)
+	}
+}
+
+func TestTreeMultisetInit(t *testing.T) {
+	t.Run("int", func(t *testing.T) {
+		multiset.NewTreeMultiset(comparator.DefaultIntegerComparator)
+	})
+	t.Run("float", func(t *testing.T) {
+		multiset.NewTreeMultiset(comparator.DefaultFloat64Comparator)
+	})
+	t.Run("string", func(t *testing.T) {
+		multiset.NewTreeMultiset(comparator.DefaultStringComparator)
+	})
+	t.Run("struct", func(t *testing.T) {
+		type S struct {
+			i int
+			_ float64
+			_ string
+		}
+		multiset.NewTreeMultiset(func(a, b S) int { return a.i - b.i })
+	})
+}
+
+func TestTreeMultisetAddRemove(t *testing.T) {
+	bag := multiset.NewTreeMultiset(comparator.DefaultIntegerComparator)
+
+	if count, err := bag.Add(5, 3); err != nil || count != 3 {
+		t.Errorf("found count (%v, %v) does not match expected count (%v, %v)", count, err, 3, nil)
+	}
+	if count, err := bag.Add(5, 2); err != nil || count != 5 {
+		t.Errorf("found count (%v, %v) does not match expected count (%v, %v)", count, err, 5, nil)
+	}
+	bag.Add(2, 1)
+	bag.Add(3, 0)
+	if _, err := bag.Add(3, -1); !errors.Is(err, multiset.ErrorNegativeCount) {
+		t.Errorf("expected ErrorNegativeCount when adding negative count, found (%v)", err)
+	}
+	checkTreeMultisetCounts(t, bag, map[int]int{5: 5, 2: 1})
+
+	if removed, err := bag.Remove(5, 2); err != nil || removed != 2 {
+		t.Errorf("found removed (%v, %v) does not match expected removed (%v, %v)", removed, err, 2, nil)
+	}
+	if removed, err := bag.Remove(2, 10); err != nil || removed != 1 {
+		t.Errorf("found removed (%v, %v) does not match expected removed (%v, %v)", removed, err, 1, nil)
+	}
+	if _, err := bag.Remove(2, 1); !errors.Is(err, dsa_error.ErrorItemNotFound) {
+		t.Errorf("expected ErrorItemNotFound when removing missing item, found (%v)", err)
+	}
+	checkTreeMultisetCounts(t, bag, map[int]int{5: 3})
+
+	bag.SetCount(5, 0)
+	bag.SetCount(1, 2)
+	checkTreeMultisetCounts(t, bag, map[int]int{1: 2})
+}
+
+func TestTreeMultisetMostCommonTies(t *testing.T) {
+	bag := multiset.NewTreeMultiset(comparator.DefaultStringComparator)
+	for _, word := range []string{"d", "c", "b", "a", "c", "d", "e"} {
+		bag.Add(word, 1)
+	}
+
+	// Items with equal counts are ordered by the comparator
+	mostCommon := bag.MostCommon(3)
+	expected := []multiset.ItemCount[string]{{Item: "c", Count: 2}, {Item: "d", Count: 2}, {Item: "a", Count: 1}}
+	if !slices.Equal(mostCommon, expected) {
+		t.Errorf("found most common (%v) does not match expected (%v)", mostCommon, expected)
+	}
+}
+
+// The ordered and hashed variants must agree under the same random operations.
+func TestTreeMultisetMatchesHashMultiset(t *testing.T) {
+	randomGenerator := rand.New(rand.NewPCG(0, 0))
+	treeBag := multiset.NewTreeMultiset(comparator.DefaultIntegerComparator)
+	hashBag := multiset.NewHashMultiset[int]()
+
+	for range 5000 {
+		item := randomGenerator.IntN(30)
+		n := randomGenerator.IntN(5)
+		switch randomGenerator.IntN(3) {
+		case 0:
+			treeBag.Add(item, n)
+			hashBag.Add(item, n)
+		case 1:
+			treeBag.Remove(item, n)
+			hashBag.Remove(item, n)
+		case 2:
+			treeBag.SetCount(item, n)
+			hashBag.SetCount(item, n)
+		}
+	}
+	checkTreeMultisetCounts(t, treeBag, maps.Collect(hashBag.Iterator()))
+
+	treeCounts := make([]int, 0)
+	for _, itemCount := range treeBag.MostCommon(10) {
+		treeCounts = append(treeCounts, itemCount.Count)
+	}
+	hashCounts := make([]int, 0)
+	for _, itemCount := range hashBag.MostCommon(10) {
+		hashCounts = append(hashCounts, itemCount.Count)
+	}
+	if !slices.Equal(treeCounts, hashCounts) {
+		t.Errorf("found most common counts (%v) does not match hashed most common counts (%v)", treeCounts, hashCounts)
+	}
+}
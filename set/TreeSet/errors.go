@@ -0,0 +1,8 @@
+package treeset
+
+import "errors"
+
+var (
+	// Returned when adding an item outside the range of a view (see HeadSet, TailSet, and SubSet).
+	ErrorItemOutOfRange = errors.New("item outside the range of set view")
+)
@@ -0,0 +1,575 @@
+package treeset
+
+import (
+	"iter"
+	"slices"
+
+	redblacktree "github.com/hmcalister/Go-DSA/tree/RedBlackTree"
+	comparator "github.com/hmcalister/Go-DSA/utils/Comparator"
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
+)
+
+// A bound on the items of a set view.
+type bound[T any] struct {
+	item      T
+	inclusive bool
+}
+
+// An implementation of a sorted set using a red-black tree as the underlying data structure.
+//
+// Items are ordered by a ComparatorFunction, rather than requiring a comparable type,
+// and iteration is always in sorted order. Add, Remove, Contains, First, Last, Floor and Ceiling are all O(log n).
+// Union, Intersection, Difference, and SymmetricDifference merge the sorted items of the two sets in linear time.
+//
+// HeadSet, TailSet, and SubSet return views of a range of the set. A view shares the tree of the set it was created from:
+// changes to the set are visible through the view, and changes made through the view are visible in the set.
+type TreeSet[T any] struct {
+	tree *redblacktree.RedBlackTree[T]
+
+	// Comparator function to compare and order the type T
+	comparatorFunction comparator.ComparatorFunction[T]
+
+	// The bounds of the items in this set, if this set is a view. A nil bound means the set is unbounded in that direction.
+	lowerBound *bound[T]
+	upperBound *bound[T]
+}
+
+// Create a new TreeSet ordered by the given comparator function.
+//
+// The comparator function (see github.com/hmcalister/Go-DSA/Comparator) defines how the items are ordered.
+// Items that compare equal are considered the same item.
+func New[T any](comparatorFunction comparator.ComparatorFunction[T]) *TreeSet[T] {
+	return &TreeSet[T]{
+		tree:               redblacktree.New(comparatorFunction),
+		comparatorFunction: comparatorFunction,
+	}
+}
+
+// Create a new TreeSet containing the given items, ordered by the given comparator function.
+// Duplicate items are added only once.
+func NewFromSlice[T any](items []T, comparatorFunction comparator.ComparatorFunction[T]) *TreeSet[T] {
+	sortedItems := slices.Clone(items)
+	slices.SortFunc(sortedItems, comparatorFunction)
+	sortedItems = slices.CompactFunc(sortedItems, func(a, b T) bool { return comparatorFunction(a, b) == 0 })
+	return newFromSorted(sortedItems, comparatorFunction)
+}
+
+// Create a new TreeSet containing the items of a sequence, ordered by the given comparator function.
+// Duplicate items are added only once.
+func NewFromSeq[T any](seq iter.Seq[T], comparatorFunction comparator.ComparatorFunction[T]) *TreeSet[T] {
+	return NewFromSlice(slices.Collect(seq), comparatorFunction)
+}
+
+// Create a new TreeSet from items known to be strictly increasing, in linear time.
+func newFromSorted[T any](sortedItems []T, comparatorFunction comparator.ComparatorFunction[T]) *TreeSet[T] {
+	// The items are known to be sorted and unique, so this cannot fail
+	tree, _ := redblacktree.NewFromSorted(sortedItems, comparatorFunction)
+	return &TreeSet[T]{
+		tree:               tree,
+		comparatorFunction: comparatorFunction,
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Bound and Search Helper Methods
+
+// Determine if an item is above the lower bound of the set.
+func (set *TreeSet[T]) aboveLowerBound(item T) bool {
+	if set.lowerBound == nil {
+		return true
+	}
+	currentCompare := set.comparatorFunction(item, set.lowerBound.item)
+	return currentCompare > 0 || (currentCompare == 0 && set.lowerBound.inclusive)
+}
+
+// Determine if an item is below the upper bound of the set.
+func (set *TreeSet[T]) belowUpperBound(item T) bool {
+	if set.upperBound == nil {
+		return true
+	}
+	currentCompare := set.comparatorFunction(item, set.upperBound.item)
+	return currentCompare < 0 || (currentCompare == 0 && set.upperBound.inclusive)
+}
+
+// Determine if an item is within the bounds of the set.
+func (set *TreeSet[T]) inRange(item T) bool {
+	return set.aboveLowerBound(item) && set.belowUpperBound(item)
+}
+
+// Find the node with the smallest item greater than (or equal to, if inclusive) the given item, ignoring the bounds of the set.
+// Returns nil if there is no such node.
+func (set *TreeSet[T]) ceilingNode(item T, inclusive bool) *redblacktree.RedBlackTreeNode[T] {
+	var resultNode *redblacktree.RedBlackTreeNode[T]
+	currentNode := set.tree.Root()
+	for currentNode != nil {
+		currentCompare := set.comparatorFunction(currentNode.Item(), item)
+		if currentCompare > 0 || (currentCompare == 0 && inclusive) {
+			resultNode = currentNode
+			currentNode = currentNode.Left()
+		} else {
+			currentNode = currentNode.Right()
+		}
+	}
+	return resultNode
+}
+
+// Find the node with the largest item less than (or equal to, if inclusive) the given item, ignoring the bounds of the set.
+// Returns nil if there is no such node.
+func (set *TreeSet[T]) floorNode(item T, inclusive bool) *redblacktree.RedBlackTreeNode[T] {
+	var resultNode *redblacktree.RedBlackTreeNode[T]
+	currentNode := set.tree.Root()
+	for currentNode != nil {
+		currentCompare := set.comparatorFunction(currentNode.Item(), item)
+		if currentCompare < 0 || (currentCompare == 0 && inclusive) {
+			resultNode = currentNode
+			currentNode = currentNode.Right()
+		} else {
+			currentNode = currentNode.Left()
+		}
+	}
+	return resultNode
+}
+
+// Count the items of the underlying tree less than (or equal to, if inclusive) the given item, using the subtree sizes of the tree.
+func (set *TreeSet[T]) countBelow(item T, inclusive bool) int {
+	count := 0
+	currentNode := set.tree.Root()
+	for currentNode != nil {
+		currentCompare := set.comparatorFunction(currentNode.Item(), item)
+		if currentCompare < 0 || (currentCompare == 0 && inclusive) {
+			count += 1
+			if currentNode.Left() != nil {
+				count += currentNode.Left().Size()
+			}
+			currentNode = currentNode.Right()
+		} else {
+			currentNode = currentNode.Left()
+		}
+	}
+	return count
+}
+
+// Find the node with the smallest item in the set, or nil if the set is empty.
+func (set *TreeSet[T]) firstNode() *redblacktree.RedBlackTreeNode[T] {
+	var node *redblacktree.RedBlackTreeNode[T]
+	if set.lowerBound != nil {
+		node = set.ceilingNode(set.lowerBound.item, set.lowerBound.inclusive)
+	} else {
+		node = set.tree.Root()
+		for node != nil && node.Left() != nil {
+			node = node.Left()
+		}
+	}
+
+	if node == nil || !set.belowUpperBound(node.Item()) {
+		return nil
+	}
+	return node
+}
+
+// Find the node with the largest item in the set, or nil if the set is empty.
+func (set *TreeSet[T]) lastNode() *redblacktree.RedBlackTreeNode[T] {
+	var node *redblacktree.RedBlackTreeNode[T]
+	if set.upperBound != nil {
+		node = set.floorNode(set.upperBound.item, set.upperBound.inclusive)
+	} else {
+		node = set.tree.Root()
+		for node != nil && node.Right() != nil {
+			node = node.Right()
+		}
+	}
+
+	if node == nil || !set.aboveLowerBound(node.Item()) {
+		return nil
+	}
+	return node
+}
+
+// Find the node with the largest item in the set less than (or equal to, if inclusive) the given item.
+// Returns nil if there is no such node.
+func (set *TreeSet[T]) floorNodeInRange(item T, inclusive bool) *redblacktree.RedBlackTreeNode[T] {
+	node := set.floorNode(item, inclusive)
+
+	// If the floor is beyond the upper bound, every item of the set is below the floor, so the largest item of the set is the answer
+	if node != nil && !set.belowUpperBound(node.Item()) {
+		node = set.lastNode()
+	}
+	if node == nil || !set.aboveLowerBound(node.Item()) {
+		return nil
+	}
+	return node
+}
+
+// Find the node with the smallest item in the set greater than (or equal to, if inclusive) the given item.
+// Returns nil if there is no such node.
+func (set *TreeSet[T]) ceilingNodeInRange(item T, inclusive bool) *redblacktree.RedBlackTreeNode[T] {
+	node := set.ceilingNode(item, inclusive)
+
+	// If the ceiling is beyond the lower bound, every item of the set is above the ceiling, so the smallest item of the set is the answer
+	if node != nil && !set.aboveLowerBound(node.Item()) {
+		node = set.firstNode()
+	}
+	if node == nil || !set.belowUpperBound(node.Item()) {
+		return nil
+	}
+	return node
+}
+
+// ----------------------------------------------------------------------------
+// Get Methods
+
+// Return the size of the set, the number of items contained.
+//
+// For a view, this counts only the items within the range of the view, in O(log n) time.
+func (set *TreeSet[T]) Size() int {
+	if set.tree.Root() == nil {
+		return 0
+	}
+
+	upperCount := set.tree.Root().Size()
+	if set.upperBound != nil {
+		upperCount = set.countBelow(set.upperBound.item, set.upperBound.inclusive)
+	}
+	lowerCount := 0
+	if set.lowerBound != nil {
+		lowerCount = set.countBelow(set.lowerBound.item, !set.lowerBound.inclusive)
+	}
+	return max(0, upperCount-lowerCount)
+}
+
+// Checks if an item is present in the set.
+func (set *TreeSet[T]) Contains(item T) bool {
+	if !set.inRange(item) {
+		return false
+	}
+	_, err := set.tree.Find(item)
+	return err == nil
+}
+
+// Get the smallest item in the set.
+//
+// Returns a dsa_error.ErrorDataStructureEmpty if the set is empty.
+func (set *TreeSet[T]) First() (T, error) {
+	node := set.firstNode()
+	if node == nil {
+		return *new(T), dsa_error.ErrorDataStructureEmpty
+	}
+	return node.Item(), nil
+}
+
+// Get the largest item in the set.
+//
+// Returns a dsa_error.ErrorDataStructureEmpty if the set is empty.
+func (set *TreeSet[T]) Last() (T, error) {
+	node := set.lastNode()
+	if node == nil {
+		return *new(T), dsa_error.ErrorDataStructureEmpty
+	}
+	return node.Item(), nil
+}
+
+// Get the largest item in the set less than or equal to the given item.
+//
+// Returns a dsa_error.ErrorItemNotFound if there is no such item.
+func (set *TreeSet[T]) Floor(item T) (T, error) {
+	node := set.floorNodeInRange(item, true)
+	if node == nil {
+		return *new(T), dsa_error.ErrorItemNotFound
+	}
+	return node.Item(), nil
+}
+
+// Get the smallest item in the set greater than or equal to the given item.
+//
+// Returns a dsa_error.ErrorItemNotFound if there is no such item.
+func (set *TreeSet[T]) Ceiling(item T) (T, error) {
+	node := set.ceilingNodeInRange(item, true)
+	if node == nil {
+		return *new(T), dsa_error.ErrorItemNotFound
+	}
+	return node.Item(), nil
+}
+
+// Get the largest item in the set strictly less than the given item.
+//
+// Returns a dsa_error.ErrorItemNotFound if there is no such item.
+func (set *TreeSet[T]) Lower(item T) (T, error) {
+	node := set.floorNodeInRange(item, false)
+	if node == nil {
+		return *new(T), dsa_error.ErrorItemNotFound
+	}
+	return node.Item(), nil
+}
+
+// Get the smallest item in the set strictly greater than the given item.
+//
+// Returns a dsa_error.ErrorItemNotFound if there is no such item.
+func (set *TreeSet[T]) Higher(item T) (T, error) {
+	node := set.ceilingNodeInRange(item, false)
+	if node == nil {
+		return *new(T), dsa_error.ErrorItemNotFound
+	}
+	return node.Item(), nil
+}
+
+// Get all items from the set in sorted order. This method allocates an array of length equal to the number of items.
+func (set *TreeSet[T]) Items() []T {
+	items := make([]T, 0, set.Size())
+	for item := range set.Iterator() {
+		items = append(items, item)
+	}
+	return items
+}
+
+// ----------------------------------------------------------------------------
+// View Methods
+
+// Return the tighter of two lower bounds. Either may be nil, meaning unbounded.
+func (set *TreeSet[T]) tighterLowerBound(a, b *bound[T]) *bound[T] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	currentCompare := set.comparatorFunction(a.item, b.item)
+	if currentCompare > 0 || (currentCompare == 0 && !a.inclusive) {
+		return a
+	}
+	return b
+}
+
+// Return the tighter of two upper bounds. Either may be nil, meaning unbounded.
+func (set *TreeSet[T]) tighterUpperBound(a, b *bound[T]) *bound[T] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	currentCompare := set.comparatorFunction(a.item, b.item)
+	if currentCompare < 0 || (currentCompare == 0 && !a.inclusive) {
+		return a
+	}
+	return b
+}
+
+// Create a view sharing the tree of this set, with the bounds of this set tightened by the given bounds.
+func (set *TreeSet[T]) view(lowerBound, upperBound *bound[T]) *TreeSet[T] {
+	return &TreeSet[T]{
+		tree:               set.tree,
+		comparatorFunction: set.comparatorFunction,
+		lowerBound:         set.tighterLowerBound(set.lowerBound, lowerBound),
+		upperBound:         set.tighterUpperBound(set.upperBound, upperBound),
+	}
+}
+
+// Get a view of the items of this set strictly less than toItem.
+//
+// The view shares storage with this set. Adding an item outside the range of the view returns an ErrorItemOutOfRange.
+func (set *TreeSet[T]) HeadSet(toItem T) *TreeSet[T] {
+	return set.view(nil, &bound[T]{item: toItem, inclusive: false})
+}
+
+// Get a view of the items of this set greater than or equal to fromItem.
+//
+// The view shares storage with this set. Adding an item outside the range of the view returns an ErrorItemOutOfRange.
+func (set *TreeSet[T]) TailSet(fromItem T) *TreeSet[T] {
+	return set.view(&bound[T]{item: fromItem, inclusive: true}, nil)
+}
+
+// Get a view of the items of this set greater than or equal to fromItem, and strictly less than toItem.
+// If fromItem is not less than toItem, the view is empty.
+//
+// The view shares storage with this set. Adding an item outside the range of the view returns an ErrorItemOutOfRange.
+func (set *TreeSet[T]) SubSet(fromItem T, toItem T) *TreeSet[T] {
+	return set.view(&bound[T]{item: fromItem, inclusive: true}, &bound[T]{item: toItem, inclusive: false})
+}
+
+// ----------------------------------------------------------------------------
+// Add Methods
+
+// Add an item to the set.
+//
+// Returns a dsa_error.ErrorItemAlreadyPresent if the item is already present in the set,
+// or an ErrorItemOutOfRange if this set is a view and the item is outside the range of the view.
+func (set *TreeSet[T]) Add(item T) error {
+	if !set.inRange(item) {
+		return ErrorItemOutOfRange
+	}
+	return set.tree.Add(item)
+}
+
+// ----------------------------------------------------------------------------
+// Remove Methods
+
+// Remove an item from the set.
+//
+// Returns a dsa_error.ErrorItemNotFound if the item is not present in the set.
+func (set *TreeSet[T]) Remove(item T) error {
+	if !set.inRange(item) {
+		return dsa_error.ErrorItemNotFound
+	}
+	return set.tree.Remove(item)
+}
+
+// Remove and return the smallest item in the set.
+//
+// Returns a dsa_error.ErrorDataStructureEmpty if the set is empty.
+func (set *TreeSet[T]) PollFirst() (T, error) {
+	item, err := set.First()
+	if err != nil {
+		return item, err
+	}
+	set.tree.Remove(item)
+	return item, nil
+}
+
+// Remove and return the largest item in the set.
+//
+// Returns a dsa_error.ErrorDataStructureEmpty if the set is empty.
+func (set *TreeSet[T]) PollLast() (T, error) {
+	item, err := set.Last()
+	if err != nil {
+		return item, err
+	}
+	set.tree.Remove(item)
+	return item, nil
+}
+
+// ----------------------------------------------------------------------------
+// Apply, Fold, and Iterator Methods
+
+// Iterate over the items of the set in sorted order and apply a function to each item.
+//
+// Idiomatic Go should likely use Iterator() rather than functional methods.
+//
+// To accumulate values over items, use Fold.
+func Apply[T any](set *TreeSet[T], f func(item T)) {
+	for item := range set.Iterator() {
+		f(item)
+	}
+}
+
+// Iterate over set items in sorted order and apply the function f.
+// The function f also takes the current value of the accumulator.
+// The results of f become the new value of the accumulator at each step.
+//
+// Idiomatic Go should likely use Iterator() rather than functional methods.
+//
+// This function is not a method on TreeSet to allow for generic accumulators.
+func Fold[T any, G any](set *TreeSet[T], initialAccumulator G, f func(item T, accumulator G) G) G {
+	accumulator := initialAccumulator
+	for item := range set.Iterator() {
+		accumulator = f(item, accumulator)
+	}
+
+	return accumulator
+}
+
+// Iterate over the items of the set in ascending order.
+// This method is not concurrency safe. For concurrent applications, consider using a mutex, or pull the data out using Items().
+func (set *TreeSet[T]) Iterator() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for node := set.firstNode(); node != nil && set.belowUpperBound(node.Item()); node = node.Successor() {
+			if !yield(node.Item()) {
+				return
+			}
+		}
+	}
+}
+
+// Iterate over the items of the set in descending order.
+// This method is not concurrency safe. For concurrent applications, consider using a mutex, or pull the data out using Items().
+func (set *TreeSet[T]) ReverseIterator() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for node := set.lastNode(); node != nil && set.aboveLowerBound(node.Item()); node = node.Predecessor() {
+			if !yield(node.Item()) {
+				return
+			}
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Set algebra methods
+//
+// Methods combining or comparing two sets. Each method merges the sorted items of both sets, taking linear time.
+// Both sets must be ordered by equivalent comparator functions. New sets are ordered by the comparator function of the receiving set.
+
+// Merge the sorted items of this set and other, keeping items only in this set, in both sets, or only in other as requested.
+func (set *TreeSet[T]) merge(other *TreeSet[T], keepOnlySet, keepBoth, keepOnlyOther bool) []T {
+	setItems := set.Items()
+	otherItems := other.Items()
+	mergedItems := make([]T, 0)
+
+	setIndex, otherIndex := 0, 0
+	for setIndex < len(setItems) && otherIndex < len(otherItems) {
+		currentCompare := set.comparatorFunction(setItems[setIndex], otherItems[otherIndex])
+		switch {
+		case currentCompare < 0:
+			if keepOnlySet {
+				mergedItems = append(mergedItems, setItems[setIndex])
+			}
+			setIndex += 1
+		case currentCompare > 0:
+			if keepOnlyOther {
+				mergedItems = append(mergedItems, otherItems[otherIndex])
+			}
+			otherIndex += 1
+		default:
+			if keepBoth {
+				mergedItems = append(mergedItems, setItems[setIndex])
+			}
+			setIndex += 1
+			otherIndex += 1
+		}
+	}
+	if keepOnlySet {
+		mergedItems = append(mergedItems, setItems[setIndex:]...)
+	}
+	if keepOnlyOther {
+		mergedItems = append(mergedItems, otherItems[otherIndex:]...)
+	}
+	return mergedItems
+}
+
+// Create a new set containing the items in either this set or other (or both).
+func (set *TreeSet[T]) Union(other *TreeSet[T]) *TreeSet[T] {
+	return newFromSorted(set.merge(other, true, true, true), set.comparatorFunction)
+}
+
+// Create a new set containing the items in both this set and other.
+func (set *TreeSet[T]) Intersection(other *TreeSet[T]) *TreeSet[T] {
+	return newFromSorted(set.merge(other, false, true, false), set.comparatorFunction)
+}
+
+// Create a new set containing the items in this set that are not in other.
+func (set *TreeSet[T]) Difference(other *TreeSet[T]) *TreeSet[T] {
+	return newFromSorted(set.merge(other, true, false, false), set.comparatorFunction)
+}
+
+// Create a new set containing the items in exactly one of this set and other.
+func (set *TreeSet[T]) SymmetricDifference(other *TreeSet[T]) *TreeSet[T] {
+	return newFromSorted(set.merge(other, true, false, true), set.comparatorFunction)
+}
+
+// Determine if every item of this set is also in other.
+func (set *TreeSet[T]) IsSubsetOf(other *TreeSet[T]) bool {
+	return len(set.merge(other, true, false, false)) == 0
+}
+
+// Determine if every item of other is also in this set.
+func (set *TreeSet[T]) IsSupersetOf(other *TreeSet[T]) bool {
+	return other.IsSubsetOf(set)
+}
+
+// Determine if this set and other have no items in common.
+func (set *TreeSet[T]) IsDisjoint(other *TreeSet[T]) bool {
+	return len(set.merge(other, false, true, false)) == 0
+}
+
+// Determine if this set and other contain exactly the same items.
+func (set *TreeSet[T]) Equal(other *TreeSet[T]) bool {
+	return len(set.merge(other, true, false, true)) == 0
+}
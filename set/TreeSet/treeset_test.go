@@ -0,0 +1,312 @@
+package treeset_test
+
+import (
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	hashset "github.com/hmcalister/Go-DSA/set/HashSet"
+	treeset "github.com/hmcalister/Go-DSA/set/TreeSet"
+	comparator "github.com/hmcalister/Go-DSA/utils/Comparator"
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
+)
+
+func newIntegerSet(items []int) *treeset.TreeSet[int] {
+	return treeset.NewFromSlice(items, comparator.DefaultIntegerComparator)
+}
+
+// a helper method to check the items of a set are exactly the expected items, in ascending order.
+//
+// calls t.Errorf if the items, reversed items, or size do not match.
+func checkTreeSetItems(t *testing.T, set *treeset.TreeSet[int], expectedItems []int) {
+	t.Helper()
+
+	items := set.Items()
+	if !slices.Equal(items, expectedItems) {
+		t.Errorf("found items (%v) does not match expected items (%v)", items, expectedItems)
+	}
+	reversedItems := slices.Collect(set.ReverseIterator())
+	slices.Reverse(reversedItems)
+	if !slices.Equal(reversedItems, expectedItems) {
+		t.Errorf("found reversed items (%v) does not match expected items (%v)", reversedItems, expectedItems)
+	}
+	if set.Size() != len(expectedItems) {
+		t.Errorf("found size (%v) does not match expected size (%v)", set.Size(), len(expectedItems))
+	}
+}
+
+func TestTreeSetInit(t *testing.T) {
+	t.Run("int", func(t *testing.T) {
+		treeset.New(comparator.DefaultIntegerComparator)
+	})
+	t.Run("float", func(t *testing.T) {
+		treeset.New(comparator.DefaultFloat64Comparator)
+	})
+	t.Run("string", func(t *testing.T) {
+		treeset.New(comparator.DefaultStringComparator)
+	})
+	t.Run("struct", func(t *testing.T) {
+		type S struct {
+			i int
+			_ float64
+			_ string
+		}
+		treeset.New(func(a, b S) int { return a.i - b.i })
+	})
+}
+
+func TestTreeSetAddContainsRemove(t *testing.T) {
+	set := treeset.New(comparator.DefaultIntegerComparator)
+	checkTreeSetItems(t, set, []int{})
+
+	for _, item := range []int{5, 1, 9, 3, 7} {
+		if err := set.Add(item); err != nil {
+			t.Errorf("error when adding new item (%v): %v", item, err)
+		}
+	}
+	if err := set.Add(3); !errors.Is(err, dsa_error.ErrorItemAlreadyPresent) {
+		t.Errorf("expected ErrorItemAlreadyPresent when adding present item, found (%v)", err)
+	}
+	checkTreeSetItems(t, set, []int{1, 3, 5, 7, 9})
+
+	if !set.Contains(7) || set.Contains(4) {
+		t.Errorf("found unexpected result from Contains")
+	}
+
+	if err := set.Remove(5); err != nil {
+		t.Errorf("error when removing present item: %v", err)
+	}
+	if err := set.Remove(5); !errors.Is(err, dsa_error.ErrorItemNotFound) {
+		t.Errorf("expected ErrorItemNotFound when removing missing item, found (%v)", err)
+	}
+	checkTreeSetItems(t, set, []int{1, 3, 7, 9})
+}
+
+func TestTreeSetNewFromSliceAndSeq(t *testing.T) {
+	checkTreeSetItems(t, newIntegerSet([]int{4, 2, 4, 8, 2, 6}), []int{2, 4, 6, 8})
+	checkTreeSetItems(t, newIntegerSet(nil), []int{})
+	checkTreeSetItems(t, treeset.NewFromSeq(slices.Values([]int{3, 1, 2, 1}), comparator.DefaultIntegerComparator), []int{1, 2, 3})
+}
+
+func TestTreeSetFirstLastPoll(t *testing.T) {
+	set := treeset.New(comparator.DefaultIntegerComparator)
+	if _, err := set.First(); !errors.Is(err, dsa_error.ErrorDataStructureEmpty) {
+		t.Errorf("expected ErrorDataStructureEmpty from empty set, found (%v)", err)
+	}
+	if _, err := set.PollLast(); !errors.Is(err, dsa_error.ErrorDataStructureEmpty) {
+		t.Errorf("expected ErrorDataStructureEmpty from empty set, found (%v)", err)
+	}
+
+	set = newIntegerSet([]int{4, 2, 8, 6})
+	if item, err := set.First(); err != nil || item != 2 {
+		t.Errorf("found first item (%v, %v) does not match expected item (%v, %v)", item, err, 2, nil)
+	}
+	if item, err := set.Last(); err != nil || item != 8 {
+		t.Errorf("found last item (%v, %v) does not match expected item (%v, %v)", item, err, 8, nil)
+	}
+	if item, err := set.PollFirst(); err != nil || item != 2 {
+		t.Errorf("found polled item (%v, %v) does not match expected item (%v, %v)", item, err, 2, nil)
+	}
+	if item, err := set.PollLast(); err != nil || item != 8 {
+		t.Errorf("found polled item (%v, %v) does not match expected item (%v, %v)", item, err, 8, nil)
+	}
+	checkTreeSetItems(t, set, []int{4, 6})
+}
+
+func TestTreeSetFloorCeiling(t *testing.T) {
+	set := newIntegerSet([]int{10, 20, 30})
+
+	testCases := []struct {
+		name          string
+		method        func(int) (int, error)
+		item          int
+		expectedItem  int
+		expectedFound bool
+	}{
+		{"floor of present item", set.Floor, 20, 20, true},
+		{"floor between items", set.Floor, 25, 20, true},
+		{"floor below all items", set.Floor, 5, 0, false},
+		{"floor above all items", set.Floor, 35, 30, true},
+		{"ceiling of present item", set.Ceiling, 20, 20, true},
+		{"ceiling between items", set.Ceiling, 15, 20, true},
+		{"ceiling above all items", set.Ceiling, 35, 0, false},
+		{"lower of present item", set.Lower, 20, 10, true},
+		{"lower of smallest item", set.Lower, 10, 0, false},
+		{"higher of present item", set.Higher, 20, 30, true},
+		{"higher of largest item", set.Higher, 30, 0, false},
+	}
+	for _, testCase := range testCases {
+		item, err := testCase.method(testCase.item)
+		if !testCase.expectedFound {
+			if !errors.Is(err, dsa_error.ErrorItemNotFound) {
+				t.Errorf("%v: expected ErrorItemNotFound, found (%v, %v)", testCase.name, item, err)
+			}
+			continue
+		}
+		if err != nil || item != testCase.expectedItem {
+			t.Errorf("%v: found (%v, %v) does not match expected (%v, %v)", testCase.name, item, err, testCase.expectedItem, nil)
+		}
+	}
+}
+
+func TestTreeSetViews(t *testing.T) {
+	set := newIntegerSet([]int{1, 2, 3, 4, 5, 6, 7, 8, 9})
+
+	checkTreeSetItems(t, set.HeadSet(4), []int{1, 2, 3})
+	checkTreeSetItems(t, set.TailSet(7), []int{7, 8, 9})
+	checkTreeSetItems(t, set.SubSet(3, 6), []int{3, 4, 5})
+	checkTreeSetItems(t, set.SubSet(6, 3), []int{})
+	checkTreeSetItems(t, set.HeadSet(0), []int{})
+
+	// Views of views are bounded by both views
+	checkTreeSetItems(t, set.SubSet(2, 8).HeadSet(5), []int{2, 3, 4})
+	checkTreeSetItems(t, set.SubSet(2, 8).TailSet(0), []int{2, 3, 4, 5, 6, 7})
+	checkTreeSetItems(t, set.HeadSet(5).TailSet(5), []int{})
+
+	// Views are bounded searches
+	view := set.SubSet(3, 7)
+	if item, err := view.Floor(100); err != nil || item != 6 {
+		t.Errorf("found floor (%v, %v) does not match expected (%v, %v)", item, err, 6, nil)
+	}
+	if item, err := view.Ceiling(-100); err != nil || item != 3 {
+		t.Errorf("found ceiling (%v, %v) does not match expected (%v, %v)", item, err, 3, nil)
+	}
+	if _, err := view.Floor(2); !errors.Is(err, dsa_error.ErrorItemNotFound) {
+		t.Errorf("expected ErrorItemNotFound for floor below view, found (%v)", err)
+	}
+	if view.Contains(8) {
+		t.Errorf("expected view to not contain item outside its range")
+	}
+}
+
+func TestTreeSetViewsShareStorage(t *testing.T) {
+	set := newIntegerSet([]int{10, 20, 30, 40})
+	view := set.SubSet(15, 35)
+	checkTreeSetItems(t, view, []int{20, 30})
+
+	// Changes to the set are visible in the view
+	set.Add(25)
+	set.Add(50)
+	set.Remove(20)
+	checkTreeSetItems(t, view, []int{25, 30})
+
+	// Changes through the view are visible in the set
+	if err := view.Add(15); err != nil {
+		t.Errorf("error when adding item in range of view: %v", err)
+	}
+	if err := view.Add(35); !errors.Is(err, treeset.ErrorItemOutOfRange) {
+		t.Errorf("expected ErrorItemOutOfRange when adding item outside view, found (%v)", err)
+	}
+	if err := view.Remove(40); !errors.Is(err, dsa_error.ErrorItemNotFound) {
+		t.Errorf("expected ErrorItemNotFound when removing item outside view, found (%v)", err)
+	}
+	if item, err := view.PollLast(); err != nil || item != 30 {
+		t.Errorf("found polled item (%v, %v) does not match expected item (%v, %v)", item, err, 30, nil)
+	}
+	checkTreeSetItems(t, set, []int{10, 15, 25, 40, 50})
+}
+
+func TestTreeSetRandomViews(t *testing.T) {
+	randomGenerator := rand.New(rand.NewPCG(0, 0))
+	for range 200 {
+		items := make([]int, randomGenerator.IntN(40))
+		for index := range items {
+			items[index] = randomGenerator.IntN(60)
+		}
+		set := newIntegerSet(items)
+		sortedItems := slices.Compact(slices.Sorted(slices.Values(items)))
+
+		fromItem := randomGenerator.IntN(70) - 5
+		toItem := randomGenerator.IntN(70) - 5
+		expectedItems := make([]int, 0)
+		for _, item := range sortedItems {
+			if fromItem <= item && item < toItem {
+				expectedItems = append(expectedItems, item)
+			}
+		}
+		view := set.SubSet(fromItem, toItem)
+		checkTreeSetItems(t, view, expectedItems)
+
+		probe := randomGenerator.IntN(70) - 5
+		floorItem, err := view.Floor(probe)
+		expectedFloorItem, expectedFound := 0, false
+		for _, item := range expectedItems {
+			if item <= probe {
+				expectedFloorItem, expectedFound = item, true
+			}
+		}
+		if (err == nil) != expectedFound || floorItem != expectedFloorItem {
+			t.Errorf("found floor (%v, %v) of (%v) in view (%v) does not match expected floor (%v, %v)", floorItem, err, probe, expectedItems, expectedFloorItem, expectedFound)
+		}
+	}
+}
+
+func TestTreeSetAlgebraMatchesHashSet(t *testing.T) {
+	randomGenerator := rand.New(rand.NewPCG(0, 0))
+	randomItems := func() []int {
+		items := make([]int, randomGenerator.IntN(30))
+		for index := range items {
+			items[index] = randomGenerator.IntN(40)
+		}
+		return items
+	}
+	sortedHashSetItems := func(set *hashset.HashSet[int]) []int {
+		return slices.Sorted(set.Iterator())
+	}
+
+	for range 100 {
+		aItems := randomItems()
+		bItems := randomItems()
+		a, b := newIntegerSet(aItems), newIntegerSet(bItems)
+		expectedA, expectedB := hashset.NewFromSlice(aItems), hashset.NewFromSlice(bItems)
+
+		checkTreeSetItems(t, a.Union(b), sortedHashSetItems(expectedA.Union(expectedB)))
+		checkTreeSetItems(t, a.Intersection(b), sortedHashSetItems(expectedA.Intersection(expectedB)))
+		checkTreeSetItems(t, a.Difference(b), sortedHashSetItems(expectedA.Difference(expectedB)))
+		checkTreeSetItems(t, a.SymmetricDifference(b), sortedHashSetItems(expectedA.SymmetricDifference(expectedB)))
+
+		if a.IsSubsetOf(b) != expectedA.IsSubsetOf(expectedB) {
+			t.Errorf("found IsSubsetOf (%v) does not match expected (%v)", a.IsSubsetOf(b), expectedA.IsSubsetOf(expectedB))
+		}
+		if a.IsSupersetOf(b) != expectedA.IsSupersetOf(expectedB) {
+			t.Errorf("found IsSupersetOf (%v) does not match expected (%v)", a.IsSupersetOf(b), expectedA.IsSupersetOf(expectedB))
+		}
+		if a.IsDisjoint(b) != expectedA.IsDisjoint(expectedB) {
+			t.Errorf("found IsDisjoint (%v) does not match expected (%v)", a.IsDisjoint(b), expectedA.IsDisjoint(expectedB))
+		}
+		if a.Equal(b) != expectedA.Equal(expectedB) {
+			t.Errorf("found Equal (%v) does not match expected (%v)", a.Equal(b), expectedA.Equal(expectedB))
+		}
+	}
+}
+
+func TestTreeSetAlgebraOnViews(t *testing.T) {
+	a := newIntegerSet([]int{1, 2, 3, 4, 5, 6})
+	b := newIntegerSet([]int{4, 5, 6, 7, 8})
+
+	checkTreeSetItems(t, a.HeadSet(5).Union(b.TailSet(7)), []int{1, 2, 3, 4, 7, 8})
+	checkTreeSetItems(t, a.TailSet(3).Intersection(b.HeadSet(6)), []int{4, 5})
+
+	// The result is a new set, independent of the viewed set
+	result := a.HeadSet(3).Union(b.HeadSet(0))
+	result.Add(10)
+	checkTreeSetItems(t, a, []int{1, 2, 3, 4, 5, 6})
+}
+
+func TestTreeSetApplyFold(t *testing.T) {
+	set := newIntegerSet([]int{3, 1, 2})
+
+	visitedItems := make([]int, 0)
+	treeset.Apply(set, func(item int) { visitedItems = append(visitedItems, item) })
+	if !slices.Equal(visitedItems, []int{1, 2, 3}) {
+		t.Errorf("found visited items (%v) are not in sorted order", visitedItems)
+	}
+
+	digits := treeset.Fold(set, 0, func(item int, accumulator int) int {
+		return 10*accumulator + item
+	})
+	if digits != 123 {
+		t.Errorf("result (%v) does not match expected result (%v)", digits, 123)
+	}
+}
@@ -2,6 +2,7 @@ package arraystack
 
 import (
 	"iter"
+	"slices"
 
 	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
 )
@@ -103,6 +104,42 @@ func (stack *ArrayStack[T]) Remove() (T, error) {
 	return item, nil
 }
 
+// Remove and return the first item in the stack matching a predicate.
+// The stack is traversed from top to bottom.
+//
+// Returns (item, nil) if an item was removed, or (*new(T), dsa_error.ErrorItemNotFound) if no item matches.
+func (stack *ArrayStack[T]) RemoveFirst(predicate func(item T) bool) (T, error) {
+	for index := len(stack.stackData) - 1; index >= 0; index -= 1 {
+		item := stack.stackData[index]
+		if predicate(item) {
+			// Shift the items above down by one, and zero the vacated slot so it is not kept reachable
+			stack.stackData = slices.Delete(stack.stackData, index, index+1)
+			return item, nil
+		}
+	}
+	return *new(T), dsa_error.ErrorItemNotFound
+}
+
+// Remove all items in the stack matching a predicate. The remaining items keep their order.
+// The stack is traversed once from bottom to top, testing each item exactly once.
+//
+// Returns the number of items removed.
+func (stack *ArrayStack[T]) RemoveAll(predicate func(item T) bool) int {
+	originalSize := len(stack.stackData)
+	stack.stackData = slices.DeleteFunc(stack.stackData, predicate)
+	return originalSize - len(stack.stackData)
+}
+
+// Keep only the items in the stack matching a predicate, removing all others. The remaining items keep their order.
+// The stack is traversed once from bottom to top, testing each item exactly once.
+//
+// Returns the number of items removed.
+func (stack *ArrayStack[T]) Retain(predicate func(item T) bool) int {
+	return stack.RemoveAll(func(item T) bool {
+		return !predicate(item)
+	})
+}
+
 // ----------------------------------------------------------------------------
 // Apply, Map, and Fold methods
 //
@@ -1,10 +1,12 @@
 package arraystack_test
 
 import (
+	"errors"
 	"slices"
 	"testing"
 
 	arraystack "github.com/hmcalister/Go-DSA/stack/ArrayStack"
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
 )
 
 func TestArrayStackRemove(t *testing.T) {
@@ -141,3 +143,28 @@ func TestArrayStackCheckFindAllAfterRemove(t *testing.T) {
 		t.Errorf("found a non-zero number of items from a stack with expected zero number of matches")
 	}
 }
+
+func TestArrayStackRemovePredicate(t *testing.T) {
+	stack := arraystack.New[int]()
+	for _, item := range []int{0, 1, 2, 3, 4, 5, 6} {
+		stack.Add(item)
+	}
+
+	// The backing array is searched from the top, so the most recently added match is removed
+	// and the items above it are shifted down
+	if item, err := stack.RemoveFirst(func(item int) bool { return item%2 == 1 }); err != nil || item != 5 {
+		t.Errorf("found removed item (%v, %v) does not match expected item (%v, %v)", item, err, 5, nil)
+	}
+	if _, err := stack.RemoveFirst(func(item int) bool { return item > 100 }); !errors.Is(err, dsa_error.ErrorItemNotFound) {
+		t.Errorf("expected ErrorItemNotFound when no item matches, found (%v)", err)
+	}
+
+	if numRemoved := stack.RemoveAll(func(item int) bool { return item%3 == 0 }); numRemoved != 3 {
+		t.Errorf("found number removed (%v) does not match expected number removed (%v)", numRemoved, 3)
+	}
+	stack.Add(7)
+	expectedItems := []int{1, 2, 4, 7}
+	if !slices.Equal(stack.Items(), expectedItems) {
+		t.Errorf("found items %v do not match expected items %v", stack.Items(), expectedItems)
+	}
+}
@@ -85,6 +85,30 @@ func (stack *LinkedListStack[T]) Remove() (T, error) {
 	return stack.stackData.Remove()
 }
 
+// Remove and return the first item in the stack matching a predicate.
+// The stack is traversed from top to bottom.
+//
+// Returns (item, nil) if an item was removed, or (*new(T), dsa_error.ErrorItemNotFound) if no item matches.
+func (stack *LinkedListStack[T]) RemoveFirst(predicate func(item T) bool) (T, error) {
+	return stack.stackData.ReverseRemoveFirst(predicate)
+}
+
+// Remove all items in the stack matching a predicate. The remaining items keep their order.
+// The stack is traversed once, testing each item exactly once.
+//
+// Returns the number of items removed.
+func (stack *LinkedListStack[T]) RemoveAll(predicate func(item T) bool) int {
+	return stack.stackData.RemoveAll(predicate)
+}
+
+// Keep only the items in the stack matching a predicate, removing all others. The remaining items keep their order.
+// The stack is traversed once, testing each item exactly once.
+//
+// Returns the number of items removed.
+func (stack *LinkedListStack[T]) Retain(predicate func(item T) bool) int {
+	return stack.stackData.Retain(predicate)
+}
+
 // ----------------------------------------------------------------------------
 // Apply, Map, and Fold methods
 //
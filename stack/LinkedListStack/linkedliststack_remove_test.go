@@ -1,10 +1,12 @@
 package linkedliststack_test
 
 import (
+	"errors"
 	"slices"
 	"testing"
 
 	linkedliststack "github.com/hmcalister/Go-DSA/stack/LinkedListStack"
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
 )
 
 func TestLinkedListStackRemove(t *testing.T) {
@@ -142,3 +144,29 @@ func TestLinkedListStackCheckFindAllAfterRemove(t *testing.T) {
 		t.Errorf("found a non-zero number of items from a stack with expected zero number of matches")
 	}
 }
+
+func TestLinkedListStackRemovePredicate(t *testing.T) {
+	stack := linkedliststack.New[int]()
+	for _, item := range []int{0, 1, 2, 3, 4} {
+		stack.Add(item)
+	}
+
+	// The list is searched from the top (the tail), so removing a match there unlinks the top node
+	if item, err := stack.RemoveFirst(func(item int) bool { return item%2 == 0 }); err != nil || item != 4 {
+		t.Errorf("found removed item (%v, %v) does not match expected item (%v, %v)", item, err, 4, nil)
+	}
+	if _, err := stack.RemoveFirst(func(item int) bool { return item > 100 }); !errors.Is(err, dsa_error.ErrorItemNotFound) {
+		t.Errorf("expected ErrorItemNotFound when no item matches, found (%v)", err)
+	}
+	if numRemoved := stack.Retain(func(item int) bool { return item != 3 }); numRemoved != 1 {
+		t.Errorf("found number removed (%v) does not match expected number removed (%v)", numRemoved, 1)
+	}
+
+	// New items must be linked on top of the remaining nodes
+	stack.Add(5)
+	for _, expectedItem := range []int{5, 2, 1, 0} {
+		if item, err := stack.Remove(); err != nil || item != expectedItem {
+			t.Errorf("found removed item (%v, %v) does not match expected item (%v, %v)", item, err, expectedItem, nil)
+		}
+	}
+}
@@ -6,4 +6,5 @@ import (
 
 var (
 	ErrorRotationNotPossible = errors.New("rotation is not possible around node")
+	ErrorItemsNotSorted      = errors.New("items are not sorted")
 )
@@ -151,27 +151,19 @@ func (node *RedBlackTreeNode[T]) Successor() *RedBlackTreeNode[T] {
 		return successorNode
 	}
 
-	// If node has a parent, find the first parent that is a left child and return that
+	// Otherwise, step up the tree until we step up from a left child, and return that parent
 	// If no such parent exists, this node has no successor
 
+	childNode := node
 	currentNode := node.parent
-	for currentNode != nil {
-		parentNode := currentNode.parent
-
-		// If the current parent is not nil and the current node is a left child, we are done
-		if parentNode != nil && parentNode.left == currentNode {
-			return currentNode
-		}
-
-		// Otherwise, continue to step up the tree
-		currentNode = parentNode
+	for currentNode != nil && currentNode.right == childNode {
+		childNode = currentNode
+		currentNode = currentNode.parent
 	}
-
-	// We did not find a parent node that was a left child
-	return nil
+	return currentNode
 }
 
-// Return the predecessor of this node, or nil if there is no successor
+// Return the predecessor of this node, or nil if there is no predecessor
 func (node *RedBlackTreeNode[T]) Predecessor() *RedBlackTreeNode[T] {
 	// If node has a left child, successor is one left then as far right as possible
 	if node.left != nil {
@@ -182,24 +174,16 @@ func (node *RedBlackTreeNode[T]) Predecessor() *RedBlackTreeNode[T] {
 		return predecessorNode
 	}
 
-	// If node has a parent, find the first parent that is a right child and return that
+	// Otherwise, step up the tree until we step up from a right child, and return that parent
 	// If no such parent exists, this node has no predecessor
 
+	childNode := node
 	currentNode := node.parent
-	for currentNode != nil {
-		parentNode := currentNode.parent
-
-		// If the current parent is not nil and the current node is a right child, we are done
-		if parentNode != nil && parentNode.right == currentNode {
-			return currentNode
-		}
-
-		// Otherwise, continue to step up the tree
-		currentNode = parentNode
+	for currentNode != nil && currentNode.left == childNode {
+		childNode = currentNode
+		currentNode = currentNode.parent
 	}
-
-	// We did not find a parent node that was a right child
-	return nil
+	return currentNode
 }
 
 // ----------------------------------------------------------------------------
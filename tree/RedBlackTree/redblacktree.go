@@ -2,6 +2,7 @@ package redblacktree
 
 import (
 	"iter"
+	"math/bits"
 
 	comparator "github.com/hmcalister/Go-DSA/utils/Comparator"
 	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
@@ -32,6 +33,59 @@ func New[T any](comparatorFunction comparator.ComparatorFunction[T]) *RedBlackTr
 	}
 }
 
+// Create a new red-black tree from items that are already sorted, in linear time.
+//
+// The items must be strictly increasing according to the comparator function.
+// Returns a dsa_error.ErrorItemAlreadyPresent if any two adjacent items are equal,
+// or an ErrorItemsNotSorted if any item is smaller than the one before it.
+func NewFromSorted[T any](items []T, comparatorFunction comparator.ComparatorFunction[T]) (*RedBlackTree[T], error) {
+	for index := 1; index < len(items); index += 1 {
+		currentCompare := comparatorFunction(items[index-1], items[index])
+		if currentCompare == 0 {
+			return nil, dsa_error.ErrorItemAlreadyPresent
+		}
+		if currentCompare > 0 {
+			return nil, ErrorItemsNotSorted
+		}
+	}
+
+	// Splitting on the middle item fills every level of the tree except the last.
+	// Coloring the nodes of the last level red (and all others black) then satisfies the red-black properties.
+	redDepth := bits.Len(uint(len(items))) - 1
+	return &RedBlackTree[T]{
+		root:               buildBalancedSubtree(items, 0, redDepth),
+		comparatorFunction: comparatorFunction,
+	}, nil
+}
+
+// Build a balanced subtree from sorted items, returning the root of the subtree (or nil if there are no items).
+//
+// Nodes at depth redDepth are colored red, excluding the root of the tree.
+func buildBalancedSubtree[T any](items []T, depth int, redDepth int) *RedBlackTreeNode[T] {
+	if len(items) == 0 {
+		return nil
+	}
+
+	middleIndex := len(items) / 2
+	node := newNode(items[middleIndex])
+	if depth == redDepth && depth > 0 {
+		node.color = color_RED
+	}
+
+	node.left = buildBalancedSubtree(items[:middleIndex], depth+1, redDepth)
+	if node.left != nil {
+		node.left.parent = node
+	}
+	node.right = buildBalancedSubtree(items[middleIndex+1:], depth+1, redDepth)
+	if node.right != nil {
+		node.right.parent = node
+	}
+
+	node.fixSize()
+	node.fixHeight()
+	return node
+}
+
 // Get the root the red-black search tree
 func (tree *RedBlackTree[T]) Root() *RedBlackTreeNode[T] {
 	return tree.root
@@ -1,12 +1,15 @@
 package redblacktree_test
 
 import (
+	"errors"
 	"iter"
+	"math/bits"
 	"slices"
 	"testing"
 
 	redblacktree "github.com/hmcalister/Go-DSA/tree/RedBlackTree"
 	comparator "github.com/hmcalister/Go-DSA/utils/Comparator"
+	dsa_error "github.com/hmcalister/Go-DSA/utils/DSA_Error"
 )
 
 func TestInitializeTreeGenericTypes(t *testing.T) {
@@ -125,3 +128,124 @@ func TestRedBlackTreeLargeIterator(t *testing.T) {
 		expectedItem += 1
 	}
 }
+
+func TestRedBlackTreeNewFromSorted(t *testing.T) {
+	for numItems := range 70 {
+		items := make([]int, numItems)
+		for i := range numItems {
+			items[i] = 2 * i
+		}
+
+		tree, err := redblacktree.NewFromSorted(items, comparator.DefaultIntegerComparator)
+		if err != nil {
+			t.Fatalf("error when creating tree from sorted items: %v", err)
+		}
+		foundOrder := slices.Collect(redblacktree.IteratorTreeInorder(tree))
+		if !slices.Equal(foundOrder, items) {
+			t.Errorf("found inorder items (%v) does not match expected items (%v)", foundOrder, items)
+		}
+		if numItems == 0 {
+			continue
+		}
+		if tree.Root().Size() != numItems {
+			t.Errorf("tree root size (%v) does not match the expected size (%v)", tree.Root().Size(), numItems)
+		}
+		minimalHeight := 0
+		for (2 << minimalHeight) <= numItems {
+			minimalHeight += 1
+		}
+		if tree.Root().Height() != minimalHeight {
+			t.Errorf("tree root height (%v) does not match the expected height (%v) for %v items", tree.Root().Height(), minimalHeight, numItems)
+		}
+
+		// The tree must remain a valid red-black tree under further additions and removals
+		for i := range numItems {
+			tree.Add(2*i + 1)
+		}
+		for i := 0; i < numItems; i += 2 {
+			tree.Remove(2 * i)
+		}
+		expectedItems := make([]int, 0)
+		for item := range 2 * numItems {
+			if item%2 == 1 || (item/2)%2 == 1 {
+				expectedItems = append(expectedItems, item)
+			}
+		}
+		foundOrder = slices.Collect(redblacktree.IteratorTreeInorder(tree))
+		if !slices.Equal(foundOrder, expectedItems) {
+			t.Errorf("found inorder items (%v) does not match expected items (%v)", foundOrder, expectedItems)
+		}
+		if tree.Root().Size() != len(expectedItems) {
+			t.Errorf("tree root size (%v) does not match the expected size (%v)", tree.Root().Size(), len(expectedItems))
+		}
+		maxHeight := 2 * bits.Len(uint(len(expectedItems)+1))
+		if tree.Root().Height() > maxHeight {
+			t.Errorf("tree root height (%v) is larger than the expected max height (%v)", tree.Root().Height(), maxHeight)
+		}
+	}
+}
+
+func TestRedBlackTreeNewFromSortedErrors(t *testing.T) {
+	if _, err := redblacktree.NewFromSorted([]int{1, 2, 2, 3}, comparator.DefaultIntegerComparator); !errors.Is(err, dsa_error.ErrorItemAlreadyPresent) {
+		t.Errorf("expected ErrorItemAlreadyPresent for duplicate items, found (%v)", err)
+	}
+	if _, err := redblacktree.NewFromSorted([]int{1, 3, 2}, comparator.DefaultIntegerComparator); !errors.Is(err, redblacktree.ErrorItemsNotSorted) {
+		t.Errorf("expected ErrorItemsNotSorted for unsorted items, found (%v)", err)
+	}
+}
+
+func TestRedBlackTreeSuccessorPredecessor(t *testing.T) {
+	items := []int{5, 3, 7, 1, 4, 6, 9, 2, 8}
+	tree := redblacktree.New[int](comparator.DefaultIntegerComparator)
+	for _, item := range items {
+		tree.Add(item)
+	}
+
+	node := tree.Root()
+	for node.Left() != nil {
+		node = node.Left()
+	}
+	foundOrder := make([]int, 0)
+	for ; node != nil; node = node.Successor() {
+		foundOrder = append(foundOrder, node.Item())
+	}
+	if !slices.Equal(foundOrder, []int{1, 2, 3, 4, 5, 6, 7, 8, 9}) {
+		t.Errorf("found successor order (%v) does not match expected order (%v)", foundOrder, []int{1, 2, 3, 4, 5, 6, 7, 8, 9})
+	}
+
+	node = tree.Root()
+	for node.Right() != nil {
+		node = node.Right()
+	}
+	foundOrder = make([]int, 0)
+	for ; node != nil; node = node.Predecessor() {
+		foundOrder = append(foundOrder, node.Item())
+	}
+	if !slices.Equal(foundOrder, []int{9, 8, 7, 6, 5, 4, 3, 2, 1}) {
+		t.Errorf("found predecessor order (%v) does not match expected order (%v)", foundOrder, []int{9, 8, 7, 6, 5, 4, 3, 2, 1})
+	}
+}
+
+// A leaf that is the left child of the root has the root as its successor, and likewise for the right child and predecessor.
+// Stepping up the tree must stop at the first parent reached from a left (or right) child, even if that parent is the root.
+func TestRedBlackTreeSuccessorPredecessorOfRootChildren(t *testing.T) {
+	tree := redblacktree.New[int](comparator.DefaultIntegerComparator)
+	for _, item := range []int{2, 1, 3} {
+		tree.Add(item)
+	}
+
+	leftChild := tree.Root().Left()
+	if successor := leftChild.Successor(); successor == nil || successor.Item() != 2 {
+		t.Errorf("found successor (%v) of left child does not match expected successor (%v)", successor, 2)
+	}
+	rightChild := tree.Root().Right()
+	if predecessor := rightChild.Predecessor(); predecessor == nil || predecessor.Item() != 2 {
+		t.Errorf("found predecessor (%v) of right child does not match expected predecessor (%v)", predecessor, 2)
+	}
+	if successor := rightChild.Successor(); successor != nil {
+		t.Errorf("found successor (%v) of largest item does not match expected successor (%v)", successor.Item(), nil)
+	}
+	if predecessor := leftChild.Predecessor(); predecessor != nil {
+		t.Errorf("found predecessor (%v) of smallest item does not match expected predecessor (%v)", predecessor.Item(), nil)
+	}
+}
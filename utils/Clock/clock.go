@@ -0,0 +1,218 @@
+package clock
+
+import (
+	"slices"
+	"sync"
+	"time"
+)
+
+// A Clock is a source of time.
+//
+// Data structures that depend on the passing of time accept a Clock, so that tests can control time deterministically.
+// Use SystemClock in production, and ManualClock in tests.
+type Clock interface {
+	// Get the current time.
+	Now() time.Time
+
+	// Get a channel that receives the current time once the duration d has passed.
+	// If d is not positive, the channel receives immediately.
+	After(d time.Duration) <-chan time.Time
+
+	// Create a timer whose channel receives the current time once the duration d has passed.
+	// If d is not positive, the channel receives immediately.
+	//
+	// Unlike After, the timer can be stopped once it is no longer needed.
+	NewTimer(d time.Duration) *Timer
+}
+
+// A single event, created by Clock.NewTimer.
+//
+// The channel C receives the current time once the timer fires.
+type Timer struct {
+	C <-chan time.Time
+
+	stop func() bool
+}
+
+// Stop the timer, preventing it from firing. Returns true if the call stops the timer,
+// or false if the timer has already fired or been stopped.
+func (timer *Timer) Stop() bool {
+	return timer.stop()
+}
+
+// ----------------------------------------------------------------------------
+// System Clock
+
+// A Clock backed by the time package.
+type SystemClock struct{}
+
+// Get the current time, using time.Now.
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+// Get a channel that receives the current time once the duration d has passed, using time.After.
+func (SystemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// Create a timer that fires once the duration d has passed, using time.NewTimer.
+func (SystemClock) NewTimer(d time.Duration) *Timer {
+	timer := time.NewTimer(d)
+	return &Timer{
+		C:    timer.C,
+		stop: timer.Stop,
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Manual Clock
+
+// A Clock that only moves when told to, intended for tests.
+//
+// Time starts at the given instant, and only changes when Advance or Set are called.
+// Channels created by After receive once the clock has been moved past their deadline.
+//
+// A ManualClock is safe for concurrent use.
+type ManualClock struct {
+	mutex   sync.Mutex
+	cond    *sync.Cond
+	now     time.Time
+	waiters []manualClockWaiter
+}
+
+type manualClockWaiter struct {
+	deadline time.Time
+	channel  chan time.Time
+}
+
+// Create a new ManualClock starting at the given time.
+func NewManualClock(start time.Time) *ManualClock {
+	clock := &ManualClock{
+		now:     start,
+		waiters: make([]manualClockWaiter, 0),
+	}
+	clock.cond = sync.NewCond(&clock.mutex)
+	return clock
+}
+
+// Get the current time of the clock.
+func (clock *ManualClock) Now() time.Time {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+
+	return clock.now
+}
+
+// Get a channel that receives the current time once the clock has been moved forward by at least d.
+// If d is not positive, the channel receives immediately.
+//
+// Note waiters are only removed once their deadline has passed, even if the channel is no longer being listened to.
+// Use NewTimer for a waiter that can be removed early.
+func (clock *ManualClock) After(d time.Duration) <-chan time.Time {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+
+	return clock.addWaiter(d)
+}
+
+// Create a timer that fires once the clock has been moved forward by at least d.
+// If d is not positive, the timer fires immediately.
+//
+// Stopping the timer removes its waiter from the clock.
+func (clock *ManualClock) NewTimer(d time.Duration) *Timer {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+
+	channel := clock.addWaiter(d)
+	return &Timer{
+		C:    channel,
+		stop: func() bool { return clock.removeWaiter(channel) },
+	}
+}
+
+// Add a waiter firing once the clock has been moved forward by at least d. Must be called while holding the mutex.
+func (clock *ManualClock) addWaiter(d time.Duration) chan time.Time {
+	// Buffer the channel so that firing never blocks, even if nobody is listening
+	channel := make(chan time.Time, 1)
+	if d <= 0 {
+		channel <- clock.now
+		return channel
+	}
+
+	clock.waiters = append(clock.waiters, manualClockWaiter{
+		deadline: clock.now.Add(d),
+		channel:  channel,
+	})
+	clock.cond.Broadcast()
+	return channel
+}
+
+// Remove the waiter with the given channel, if it has not yet fired. Returns true if the waiter was removed.
+func (clock *ManualClock) removeWaiter(channel chan time.Time) bool {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+
+	for index, waiter := range clock.waiters {
+		if waiter.channel == channel {
+			clock.waiters = slices.Delete(clock.waiters, index, index+1)
+			return true
+		}
+	}
+	return false
+}
+
+// Move the clock forward by the duration d, firing any waiters whose deadline has passed.
+func (clock *ManualClock) Advance(d time.Duration) {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+
+	clock.setTime(clock.now.Add(d))
+}
+
+// Set the clock to the given time, firing any waiters whose deadline has passed.
+//
+// Setting the clock backwards is allowed, but will not fire any waiters.
+func (clock *ManualClock) Set(now time.Time) {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+
+	clock.setTime(now)
+}
+
+// Set the time and fire waiters. Must be called while holding the mutex.
+func (clock *ManualClock) setTime(now time.Time) {
+	clock.now = now
+
+	remainingWaiters := clock.waiters[:0]
+	for _, waiter := range clock.waiters {
+		if waiter.deadline.After(now) {
+			remainingWaiters = append(remainingWaiters, waiter)
+		} else {
+			waiter.channel <- now
+		}
+	}
+	clear(clock.waiters[len(remainingWaiters):])
+	clock.waiters = remainingWaiters
+	clock.cond.Broadcast()
+}
+
+// Get the number of channels created by After or NewTimer that have not yet fired (or been stopped).
+func (clock *ManualClock) Waiters() int {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+
+	return len(clock.waiters)
+}
+
+// Block until at least n channels created by After or NewTimer are waiting to fire.
+//
+// This is useful in tests to ensure another goroutine is waiting on the clock before advancing it.
+func (clock *ManualClock) BlockUntilWaiters(n int) {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+
+	for len(clock.waiters) < n {
+		clock.cond.Wait()
+	}
+}
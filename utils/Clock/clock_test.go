@@ -0,0 +1,81 @@
+package clock_test
+
+import (
+	"testing"
+	"time"
+
+	clock "github.com/hmcalister/Go-DSA/utils/Clock"
+)
+
+func TestManualClockAdvance(t *testing.T) {
+	startTime := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	manualClock := clock.NewManualClock(startTime)
+
+	channel := manualClock.After(2 * time.Second)
+	if manualClock.Waiters() != 1 {
+		t.Errorf("clock waiters (%v) does not match expected waiters (%v)", manualClock.Waiters(), 1)
+	}
+
+	manualClock.Advance(time.Second)
+	select {
+	case <-channel:
+		t.Errorf("channel fired before deadline")
+	default:
+	}
+
+	manualClock.Advance(time.Second)
+	select {
+	case firedTime := <-channel:
+		if !firedTime.Equal(startTime.Add(2 * time.Second)) {
+			t.Errorf("fired time (%v) does not match expected time (%v)", firedTime, startTime.Add(2*time.Second))
+		}
+	default:
+		t.Errorf("channel did not fire after deadline")
+	}
+
+	if manualClock.Waiters() != 0 {
+		t.Errorf("clock waiters (%v) does not match expected waiters (%v)", manualClock.Waiters(), 0)
+	}
+}
+
+func TestManualClockAfterNonPositive(t *testing.T) {
+	manualClock := clock.NewManualClock(time.Time{})
+
+	select {
+	case <-manualClock.After(0):
+	default:
+		t.Errorf("channel did not fire immediately for zero duration")
+	}
+}
+
+func TestManualClockTimerStop(t *testing.T) {
+	manualClock := clock.NewManualClock(time.Time{})
+
+	timer := manualClock.NewTimer(time.Second)
+	if manualClock.Waiters() != 1 {
+		t.Errorf("clock waiters (%v) does not match expected waiters (%v)", manualClock.Waiters(), 1)
+	}
+	if !timer.Stop() {
+		t.Errorf("expected stopping a pending timer to return true")
+	}
+	if manualClock.Waiters() != 0 {
+		t.Errorf("clock waiters (%v) does not match expected waiters (%v)", manualClock.Waiters(), 0)
+	}
+
+	manualClock.Advance(time.Second)
+	select {
+	case <-timer.C:
+		t.Errorf("stopped timer fired")
+	default:
+	}
+	if timer.Stop() {
+		t.Errorf("expected stopping a stopped timer to return false")
+	}
+
+	firedTimer := manualClock.NewTimer(time.Second)
+	manualClock.Advance(time.Second)
+	<-firedTimer.C
+	if firedTimer.Stop() {
+		t.Errorf("expected stopping a fired timer to return false")
+	}
+}
@@ -8,5 +8,8 @@ var (
 	ErrorItemAlreadyPresent = errors.New("item already present in data structure")
 	ErrorItemNotFound       = errors.New("item not present in data structure")
 	ErrorDataStructureEmpty = errors.New("data structure is empty")
+	ErrorDataStructureFull  = errors.New("data structure is full")
+	ErrorQueueClosed        = errors.New("queue is closed")
+	ErrorInvalidCapacity    = errors.New("capacity must be positive")
 	ErrorIndexOutOfBounds   = errors.New("index out of bounds")
 )
@@ -0,0 +1,47 @@
+package hasher
+
+import (
+	"hash/maphash"
+	"math"
+)
+
+// The seed for hashes of strings and bytes, chosen randomly when the program starts.
+var defaultSeed = maphash.MakeSeed()
+
+// Compare items using Go's built in equality operator.
+
+func DefaultEqualityFunction[T comparable](a, b T) bool {
+	return a == b
+}
+
+// Integers are their own hash, as hash tables mix the bits of the hash anyway
+
+func DefaultIntegerHashFunction(item int) uint64 {
+	return uint64(item)
+}
+
+// Floats are hashed by their bit pattern, so that 0.0 and -0.0 (which compare equal) are given the same hash
+
+func DefaultFloat32HashFunction(item float32) uint64 {
+	if item == 0 {
+		return 0
+	}
+	return uint64(math.Float32bits(item))
+}
+
+func DefaultFloat64HashFunction(item float64) uint64 {
+	if item == 0 {
+		return 0
+	}
+	return math.Float64bits(item)
+}
+
+// Strings and byte slices are hashed using hash/maphash
+
+func DefaultStringHashFunction(item string) uint64 {
+	return maphash.String(defaultSeed, item)
+}
+
+func DefaultBytesHashFunction(item []byte) uint64 {
+	return maphash.Bytes(defaultSeed, item)
+}
@@ -0,0 +1,10 @@
+package hasher
+
+// Hash functions take an item and return a hash of that item.
+//
+// Items that are equal (according to the corresponding EqualityFunction) must have equal hashes.
+// Hash tables mix the bits of the returned hash, so a hash function need not distribute its results uniformly itself.
+type HashFunction[T any] func(item T) uint64
+
+// Equality functions take two items, a and b, and return true if the items are considered equal.
+type EqualityFunction[T any] func(a, b T) bool